@@ -4,9 +4,13 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/slice"
+	"github.com/mheidinger/advent-of-code-go/mathy"
+	"github.com/mheidinger/advent-of-code-go/parse"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -39,59 +43,33 @@ func main() {
 }
 
 func part1(input string) int {
-	parsed := parseInput(input)
-
-	highestCal := 0
-	for _, elf := range parsed {
-		elfCal := 0
-		for _, cal := range elf {
-			elfCal += cal
-		}
-		if elfCal > highestCal {
-			highestCal = elfCal
-		}
-	}
-
+	highestCal, _ := mathy.Max(elfCalories(parseInput(input))...)
 	return highestCal
 }
 
 func part2(input string) int {
-	parsed := parseInput(input)
-
-	highestCal1 := -1
-	highestCal2 := -2
-	highestCal3 := -3
-	for _, elf := range parsed {
-		elfCal := 0
-		for _, cal := range elf {
-			elfCal += cal
-		}
-		if elfCal > highestCal1 && highestCal1 < highestCal2 && highestCal1 < highestCal3 {
-			highestCal1 = elfCal
-			continue
-		}
-		if elfCal > highestCal2 && highestCal2 < highestCal3 && highestCal2 < highestCal1 {
-			highestCal2 = elfCal
-			continue
-		}
-		if elfCal > highestCal3 && highestCal3 < highestCal2 && highestCal3 < highestCal1 {
-			highestCal3 = elfCal
-			continue
-		}
+	calories := elfCalories(parseInput(input))
+	sort.Sort(sort.Reverse(sort.IntSlice(calories)))
+	if len(calories) > 3 {
+		calories = calories[:3]
 	}
+	return mathy.Sum(calories...)
+}
 
-	return highestCal1 + highestCal2 + highestCal3
+// elfCalories sums up the calories carried by every elf
+func elfCalories(elves [][]int) []int {
+	return slice.Map(elves, func(elf []int) int {
+		return mathy.Sum(elf...)
+	})
 }
 
 func parseInput(input string) (ans [][]int) {
-	currentElf := []int{}
-	for _, line := range strings.Split(input, "\n") {
-		if len(strings.TrimSpace(line)) == 0 {
-			ans = append(ans, currentElf)
-			currentElf = []int{}
-			continue
+	for _, elf := range parse.BlockLines(input) {
+		calories := []int{}
+		for _, line := range elf {
+			calories = append(calories, cast.ToInt(line))
 		}
-		currentElf = append(currentElf, cast.ToInt(line))
+		ans = append(ans, calories)
 	}
 	return ans
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/set"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -38,47 +39,17 @@ func main() {
 	}
 }
 
-type Range struct {
-	Lower  int
-	Higher int
-}
-
-func (r Range) size() int {
-	return r.Higher - r.Lower
-}
-
 type Pair struct {
-	FirstRange  Range
-	SecondRange Range
+	FirstRange  set.Interval
+	SecondRange set.Interval
 }
 
 func (p Pair) fullyContained() bool {
-	first := p.FirstRange
-	second := p.SecondRange
-	if p.SecondRange.size() > p.FirstRange.size() {
-		second = p.FirstRange
-		first = p.SecondRange
-	}
-
-	if second.Lower >= first.Lower && second.Higher <= first.Higher {
-		return true
-	}
-	return false
+	return p.FirstRange.ContainsInterval(p.SecondRange) || p.SecondRange.ContainsInterval(p.FirstRange)
 }
 
 func (p Pair) partlyContained() bool {
-	first := p.FirstRange
-	second := p.SecondRange
-	if p.SecondRange.Lower < p.FirstRange.Lower {
-		second = p.FirstRange
-		first = p.SecondRange
-	}
-
-	if (first.Lower <= second.Lower && first.Higher >= second.Lower) ||
-		(first.Lower <= second.Higher && first.Higher >= second.Higher) {
-		return true
-	}
-	return false
+	return p.FirstRange.Overlaps(p.SecondRange)
 }
 
 func part1(input string) int {
@@ -113,8 +84,8 @@ func parseInput(input string) (ans []Pair) {
 		rangeSplit1 := strings.Split(pairSplit[0], "-")
 		rangeSplit2 := strings.Split(pairSplit[1], "-")
 		pair := Pair{
-			Range{cast.ToInt(rangeSplit1[0]), cast.ToInt(rangeSplit1[1])},
-			Range{cast.ToInt(rangeSplit2[0]), cast.ToInt(rangeSplit2[1])},
+			set.Closed(cast.ToInt(rangeSplit1[0]), cast.ToInt(rangeSplit1[1])),
+			set.Closed(cast.ToInt(rangeSplit2[0]), cast.ToInt(rangeSplit2[1])),
 		}
 		ans = append(ans, pair)
 	}
@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/stack"
+	"github.com/mheidinger/advent-of-code-go/parse"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -44,53 +45,42 @@ func main() {
 	}
 }
 
-func reverse(s []string) []string {
-	a := make([]string, len(s))
-	copy(a, s)
-
-	for i := len(a)/2 - 1; i >= 0; i-- {
-		opp := len(a) - 1 - i
-		a[i], a[opp] = a[opp], a[i]
-	}
-
-	return a
-}
-
 func part1(input string) string {
 	commands := parseInput(input)
-	stacks := getInitialState()
+	stacks := toStacks(getInitialState())
 
 	for _, cmd := range commands {
-		fromLen := len(stacks[cmd.From])
-		move := reverse(stacks[cmd.From][fromLen-cmd.Amount:])
-		stacks[cmd.From] = stacks[cmd.From][:fromLen-cmd.Amount]
-		stacks[cmd.To] = append(stacks[cmd.To], move...)
-	}
-
-	res := ""
-	for _, stack := range stacks {
-		res += stack[len(stack)-1]
+		stacks[cmd.From].MoveOneByOne(stacks[cmd.To], cmd.Amount)
 	}
 
-	return res
+	return topCrates(stacks)
 }
 
 func part2(input string) string {
 	commands := parseInput(input)
-	stacks := getInitialState()
+	stacks := toStacks(getInitialState())
 
 	for _, cmd := range commands {
-		fromLen := len(stacks[cmd.From])
-		move := stacks[cmd.From][fromLen-cmd.Amount:]
-		stacks[cmd.From] = stacks[cmd.From][:fromLen-cmd.Amount]
-		stacks[cmd.To] = append(stacks[cmd.To], move...)
+		stacks[cmd.From].MoveAtOnce(stacks[cmd.To], cmd.Amount)
 	}
 
-	res := ""
-	for _, stack := range stacks {
-		res += stack[len(stack)-1]
+	return topCrates(stacks)
+}
+
+func toStacks(crates [][]string) []*stack.Stack[string] {
+	stacks := make([]*stack.Stack[string], len(crates))
+	for it, stackCrates := range crates {
+		stacks[it] = stack.New(stackCrates...)
 	}
+	return stacks
+}
 
+func topCrates(stacks []*stack.Stack[string]) string {
+	res := ""
+	for _, crateStack := range stacks {
+		top, _ := crateStack.Peek()
+		res += top
+	}
 	return res
 }
 
@@ -132,11 +122,11 @@ func getInitialTestState() [][]string {
 
 func parseInput(input string) (ans []Command) {
 	for _, line := range strings.Split(input, "\n") {
-		lineSplit := strings.Split(line, " ")
+		nums := parse.Ints(line)
 		cmd := Command{
-			Amount: cast.ToInt(lineSplit[1]),
-			From:   cast.ToInt(lineSplit[3]) - 1,
-			To:     cast.ToInt(lineSplit[5]) - 1,
+			Amount: nums[0],
+			From:   nums[1] - 1,
+			To:     nums[2] - 1,
 		}
 		ans = append(ans, cmd)
 	}
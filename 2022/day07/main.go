@@ -91,13 +91,6 @@ func (i *Item) SumLargeDirsBelowThreshold(threshold int) int {
 	return returnSize
 }
 
-func Abs(x int) int {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
 func (i *Item) FindDirToDelete(spaceToFree, currDeletionSize int) int {
 	if i.size > spaceToFree && i.size-spaceToFree < currDeletionSize-spaceToFree {
 		currDeletionSize = i.size
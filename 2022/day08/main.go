@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/grid"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -45,49 +46,47 @@ func main() {
 
 func part1(input string) int {
 	forest := parseInput(input)
-	last := len(forest) - 1
+	last := forest.Height() - 1
 
 	// go through rows/columns from both sides, remember highest tree, mark trees higher as visible
-	for x := 0; x < len(forest); x++ {
+	for x := 0; x < forest.Height(); x++ {
 		highestTreeLeft := -1
 		highestTreeRight := -1
 		highestTreeTop := -1
 		highestTreeBottom := -1
-		for y := 0; y < len(forest[x]); y++ {
-			if forest[x][y].height > highestTreeLeft {
-				highestTreeLeft = forest[x][y].height
-				forest[x][y].visible = true
+		for y := 0; y < forest.Width(); y++ {
+			if tree := forest.Get(x, y); tree.height > highestTreeLeft {
+				highestTreeLeft = tree.height
+				tree.visible = true
 			}
-			if forest[x][last-y].height > highestTreeRight {
-				highestTreeRight = forest[x][last-y].height
-				forest[x][last-y].visible = true
+			if tree := forest.Get(x, last-y); tree.height > highestTreeRight {
+				highestTreeRight = tree.height
+				tree.visible = true
 			}
-			if forest[y][x].height > highestTreeTop {
-				highestTreeTop = forest[y][x].height
-				forest[y][x].visible = true
+			if tree := forest.Get(y, x); tree.height > highestTreeTop {
+				highestTreeTop = tree.height
+				tree.visible = true
 			}
-			if forest[last-y][x].height > highestTreeBottom {
-				highestTreeBottom = forest[last-y][x].height
-				forest[last-y][x].visible = true
+			if tree := forest.Get(last-y, x); tree.height > highestTreeBottom {
+				highestTreeBottom = tree.height
+				tree.visible = true
 			}
 		}
 	}
 
 	numVisible := 0
-	for x := 0; x < len(forest); x++ {
-		for y := 0; y < len(forest[x]); y++ {
-			if forest[x][y].visible {
-				numVisible++
-			}
+	forest.Each(func(_, _ int, tree *Tree) {
+		if tree.visible {
+			numVisible++
 		}
-	}
+	})
 
 	return numVisible
 }
 
-func getScenicScore(forest [][]*Tree, x, y int) int {
-	treeHeight := forest[x][y].height
-	size := len(forest)
+func getScenicScore(forest *grid.Grid[*Tree], x, y int) int {
+	treeHeight := forest.Get(x, y).height
+	size := forest.Height()
 
 	checkLeft := x - 1
 	sightLeft := 0
@@ -104,7 +103,7 @@ func getScenicScore(forest [][]*Tree, x, y int) int {
 
 		if checkLeft >= 0 {
 			sightLeft++
-			if forest[checkLeft][y].height >= treeHeight {
+			if forest.Get(checkLeft, y).height >= treeHeight {
 				checkLeft = -1
 			} else {
 				checkLeft--
@@ -112,7 +111,7 @@ func getScenicScore(forest [][]*Tree, x, y int) int {
 		}
 		if checkRight < size {
 			sightRight++
-			if forest[checkRight][y].height >= treeHeight {
+			if forest.Get(checkRight, y).height >= treeHeight {
 				checkRight = size
 			} else {
 				checkRight++
@@ -120,7 +119,7 @@ func getScenicScore(forest [][]*Tree, x, y int) int {
 		}
 		if checkTop >= 0 {
 			sightTop++
-			if forest[x][checkTop].height >= treeHeight {
+			if forest.Get(x, checkTop).height >= treeHeight {
 				checkTop = -1
 			} else {
 				checkTop--
@@ -128,7 +127,7 @@ func getScenicScore(forest [][]*Tree, x, y int) int {
 		}
 		if checkBottom < size {
 			sightBottom++
-			if forest[x][checkBottom].height >= treeHeight {
+			if forest.Get(x, checkBottom).height >= treeHeight {
 				checkBottom = size
 			} else {
 				checkBottom++
@@ -143,27 +142,19 @@ func part2(input string) int {
 	forest := parseInput(input)
 
 	highestScore := 0
-	for x := 0; x < len(forest); x++ {
-		for y := 0; y < len(forest[x]); y++ {
-			if score := getScenicScore(forest, x, y); score > highestScore {
-				highestScore = score
-			}
+	forest.Each(func(x, y int, _ *Tree) {
+		if score := getScenicScore(forest, x, y); score > highestScore {
+			highestScore = score
 		}
-	}
+	})
 	return highestScore
 }
 
-func parseInput(input string) (ans [][]*Tree) {
-	for _, line := range strings.Split(input, "\n") {
-		rowNums := strings.Split(line, "")
-		row := make([]*Tree, 0, len(rowNums))
-		for _, num := range rowNums {
-			row = append(row, &Tree{
-				height:  cast.ToInt(num),
-				visible: false,
-			})
+func parseInput(input string) *grid.Grid[*Tree] {
+	return grid.MustParse(input, func(char rune) *Tree {
+		return &Tree{
+			height:  cast.ToInt(string(char)),
+			visible: false,
 		}
-		ans = append(ans, row)
-	}
-	return ans
+	})
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/geom"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -39,103 +40,39 @@ func main() {
 }
 
 type Command struct {
-	direction string
+	direction geom.Vec2
 	steps     int
 }
 
-type Position struct {
-	x int
-	y int
-}
-
-func Abs(x int) int {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
-func follow(head, tail Position) Position {
-	diffX := head.x - tail.x
-	diffY := head.y - tail.y
-	if Abs(diffX) <= 1 && Abs(diffY) <= 1 {
+func follow(head, tail geom.Vec2) geom.Vec2 {
+	diff := head.Sub(tail)
+	if diff.Chebyshev(geom.Vec2{}) <= 1 {
 		return tail
 	}
+	// move a single step towards the head, diagonally if not in line
+	return tail.Add(diff.Sign())
+}
 
-	// linear diff
-	if diffY == 0 && diffX > 0 {
-		tail.x++
-	} else if diffY == 0 && diffX < 0 {
-		tail.x--
-	} else if diffX == 0 && diffY > 0 {
-		tail.y++
-	} else if diffX == 0 && diffY < 0 {
-		tail.y--
-	}
-	if diffX == 0 || diffY == 0 {
-		return tail
-	}
-
-	// diagonal diff, even
-	if Abs(diffX) == 2 && Abs(diffY) == 2 {
-		if diffX > 0 {
-			tail.x++
-		} else {
-			tail.x--
-		}
-		if diffY > 0 {
-			tail.y++
-		} else {
-			tail.y--
-		}
-		return tail
-	}
-
-	// diagonal diff, uneven
-	if Abs(diffY) >= 2 && diffY > 0 {
-		tail.x = head.x
-		tail.y++
-	} else if Abs(diffY) >= 2 && diffY < 0 {
-		tail.x = head.x
-		tail.y--
-	} else if Abs(diffX) >= 2 && diffX > 0 {
-		tail.y = head.y
-		tail.x++
-	} else if Abs(diffX) >= 2 && diffX < 0 {
-		tail.y = head.y
-		tail.x--
-	}
-
-	return tail
+var directions = map[string]geom.Vec2{
+	"R": geom.R,
+	"L": geom.L,
+	"U": geom.U,
+	"D": geom.D,
 }
 
 func part1(input string) int {
 	commands := parseInput(input)
 
-	visitMap := map[string]bool{} // keys are x:y
-	head := Position{0, 0}
-	tail := Position{0, 0}
+	visitMap := map[geom.Vec2]bool{}
+	head := geom.Vec2{}
+	tail := geom.Vec2{}
 
 	for _, cmd := range commands {
 		for it := 0; it < cmd.steps; it++ {
-			switch cmd.direction {
-			case "R":
-				head.x++
-			case "L":
-				head.x--
-			case "U":
-				head.y++
-			case "D":
-				head.y--
-			default:
-				panic(fmt.Errorf("unknown direction: %s", cmd.direction))
-			}
-
+			head = head.Add(cmd.direction)
 			tail = follow(head, tail)
-			visitMap[fmt.Sprintf("%d:%d", tail.x, tail.y)] = true
-			fmt.Printf("head: %d:%d, tail: %d:%d\n", head.x, head.y, tail.x, tail.y)
+			visitMap[tail] = true
 		}
-		fmt.Println("------")
 	}
 
 	return len(visitMap)
@@ -144,28 +81,17 @@ func part1(input string) int {
 func part2(input string) int {
 	commands := parseInput(input)
 
-	visitMap := map[string]bool{} // keys are x:y
-	rope := make([]Position, 10)
+	visitMap := map[geom.Vec2]bool{}
+	rope := make([]geom.Vec2, 10)
 
 	for _, cmd := range commands {
 		for it := 0; it < cmd.steps; it++ {
-			switch cmd.direction {
-			case "R":
-				rope[0].x++
-			case "L":
-				rope[0].x--
-			case "U":
-				rope[0].y++
-			case "D":
-				rope[0].y--
-			default:
-				panic(fmt.Errorf("unknown direction: %s", cmd.direction))
-			}
+			rope[0] = rope[0].Add(cmd.direction)
 
 			for knotPos := range rope {
 				rope[knotPos+1] = follow(rope[knotPos], rope[knotPos+1])
 				if knotPos == len(rope)-2 {
-					visitMap[fmt.Sprintf("%d:%d", rope[knotPos+1].x, rope[knotPos+1].y)] = true
+					visitMap[rope[knotPos+1]] = true
 					break
 				}
 			}
@@ -184,8 +110,12 @@ func part2(input string) int {
 func parseInput(input string) (ans []Command) {
 	for _, line := range strings.Split(input, "\n") {
 		lineParts := strings.Split(line, " ")
+		direction, ok := directions[lineParts[0]]
+		if !ok {
+			panic(fmt.Errorf("unknown direction: %s", lineParts[0]))
+		}
 		ans = append(ans, Command{
-			direction: lineParts[0],
+			direction: direction,
 			steps:     cast.ToInt(lineParts[1]),
 		})
 	}
@@ -2,74 +2,76 @@ package main
 
 import (
 	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/geom"
 )
 
 func TestFollow(t *testing.T) {
 	tests := []struct {
 		name         string
-		inputHead    Position
-		inputTail    Position
-		expectedTail Position
+		inputHead    geom.Vec2
+		inputTail    geom.Vec2
+		expectedTail geom.Vec2
 	}{
 		{
 			name:         "same",
-			inputHead:    Position{0, 0},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{0, 0},
+			inputHead:    geom.Vec2{X: 0, Y: 0},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 0, Y: 0},
 		},
 		{
 			name:         "1up",
-			inputHead:    Position{1, 0},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{0, 0},
+			inputHead:    geom.Vec2{X: 1, Y: 0},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 0, Y: 0},
 		},
 		{
 			name:         "1right",
-			inputHead:    Position{0, 1},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{0, 0},
+			inputHead:    geom.Vec2{X: 0, Y: 1},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 0, Y: 0},
 		},
 		{
 			name:         "2up",
-			inputHead:    Position{2, 0},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{1, 0},
+			inputHead:    geom.Vec2{X: 2, Y: 0},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 1, Y: 0},
 		},
 		{
 			name:         "2right",
-			inputHead:    Position{0, 2},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{0, 1},
+			inputHead:    geom.Vec2{X: 0, Y: 2},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 0, Y: 1},
 		},
 		{
 			name:         "2up1right",
-			inputHead:    Position{2, 1},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{1, 1},
+			inputHead:    geom.Vec2{X: 2, Y: 1},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 1, Y: 1},
 		},
 		{
 			name:         "2down1left",
-			inputHead:    Position{-2, -1},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{-1, -1},
+			inputHead:    geom.Vec2{X: -2, Y: -1},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: -1, Y: -1},
 		},
 		{
 			name:         "2right1up",
-			inputHead:    Position{1, 2},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{1, 1},
+			inputHead:    geom.Vec2{X: 1, Y: 2},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 1, Y: 1},
 		},
 		{
 			name:         "2right2up",
-			inputHead:    Position{2, 2},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{1, 1},
+			inputHead:    geom.Vec2{X: 2, Y: 2},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: 1, Y: 1},
 		},
 		{
 			name:         "2left2down",
-			inputHead:    Position{-2, -2},
-			inputTail:    Position{0, 0},
-			expectedTail: Position{-1, -1},
+			inputHead:    geom.Vec2{X: -2, Y: -2},
+			inputTail:    geom.Vec2{X: 0, Y: 0},
+			expectedTail: geom.Vec2{X: -1, Y: -1},
 		},
 	}
 
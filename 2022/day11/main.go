@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/mathy"
+	"github.com/mheidinger/advent-of-code-go/parse"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -124,10 +126,11 @@ func part1(input string) int {
 func part2(input string) int {
 	monkeys := parseInput(input)
 
-	lcm := 1
+	divisors := []int{}
 	for _, monkey := range monkeys {
-		lcm *= monkey.testDivisible
+		divisors = append(divisors, monkey.testDivisible)
 	}
+	lcm := mathy.LCM(divisors...)
 
 	for it := 0; it < 10000; it++ {
 		for _, monkey := range monkeys {
@@ -150,29 +153,58 @@ func part2(input string) int {
 }
 
 func parseInput(input string) (ans []*Monkey) {
-	lines := strings.Split(input, "\n")
-
-	for it := 0; it < 8; it++ {
-		monk := &Monkey{items: []int{}}
-		itemsList := strings.Split(lines[it*7+1], ": ")[1]
-		items := strings.Split(itemsList, ", ")
-		for _, item := range items {
-			monk.items = append(monk.items, cast.ToInt(item))
+	blocks, starts := parse.BlockLinesAt(input)
+	for it, lines := range blocks {
+		monk, err := parseMonkey(lines, starts[it])
+		if err != nil {
+			panic(fmt.Errorf("monkey %d: %w", it, err))
 		}
-		opLineSplit := strings.Split(strings.TrimSpace(lines[it*7+2]), " ")
-		monk.op = Operation{op: Operand(opLineSplit[4])}
-		if opLineSplit[5] == "old" {
-			monk.op.val = 0
-		} else {
-			monk.op.val = cast.ToInt(opLineSplit[5])
-		}
-		testStr := strings.Split(lines[it*7+3], "by ")[1]
-		monk.testDivisible = cast.ToInt(testStr)
-		trueStr := strings.Split(lines[it*7+4], "monkey ")[1]
-		monk.testOkTarget = cast.ToInt(trueStr)
-		falseStr := strings.Split(lines[it*7+5], "monkey ")[1]
-		monk.testFailTarget = cast.ToInt(falseStr)
 		ans = append(ans, monk)
 	}
 	return ans
 }
+
+// parseMonkey parses the lines of one monkey, offset is the index of its first
+// line in the input to report errors with the line number of the input
+func parseMonkey(lines []string, offset int) (*Monkey, error) {
+	if len(lines) != 6 {
+		return nil, fmt.Errorf("line %d: got %d lines, want 6", offset+1, len(lines))
+	}
+	// the test and target lines end with their value
+	lastField := func(line int) string {
+		fields := strings.Fields(lines[line])
+		return fields[len(fields)-1]
+	}
+	wrap := func(line int, err error) error {
+		return fmt.Errorf("line %d %q: %w", offset+line+1, lines[line], err)
+	}
+
+	_, itemList, ok := strings.Cut(lines[1], ": ")
+	if !ok {
+		return nil, wrap(1, fmt.Errorf("missing items"))
+	}
+	items, err := cast.ToInts(itemList, ",")
+	if err != nil {
+		return nil, wrap(1, err)
+	}
+	monk := &Monkey{items: items}
+
+	opLineSplit := strings.Fields(lines[2])
+	if len(opLineSplit) != 6 {
+		return nil, wrap(2, fmt.Errorf("malformed operation"))
+	}
+	monk.op = Operation{op: Operand(opLineSplit[4])}
+	if opLineSplit[5] != "old" {
+		if monk.op.val, err = cast.Parse[int](opLineSplit[5]); err != nil {
+			return nil, wrap(2, err)
+		}
+	}
+
+	targets := []*int{&monk.testDivisible, &monk.testOkTarget, &monk.testFailTarget}
+	for it, target := range targets {
+		if *target, err = cast.Parse[int](lastField(it + 3)); err != nil {
+			return nil, wrap(it+3, err)
+		}
+	}
+	return monk, nil
+}
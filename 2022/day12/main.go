@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/grid"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -52,8 +53,8 @@ type Tile struct {
 	tentativeDistance int
 }
 
-func findShortestPath(land [][]*Tile, start, goal Point) {
-	current := land[start.x][start.y]
+func findShortestPath(land *grid.Grid[*Tile], start, goal Point) {
+	current := land.Get(start.x, start.y)
 
 	for current != nil {
 		neighbours := getNeighbours(land, current)
@@ -71,18 +72,10 @@ func findShortestPath(land [][]*Tile, start, goal Point) {
 	}
 }
 
-func getNeighbours(land [][]*Tile, current *Tile) (neighbours []*Tile) {
-	sides := []Point{
-		{1, 0}, {-1, 0}, {0, 1}, {0, -1},
-	}
-
+func getNeighbours(land *grid.Grid[*Tile], current *Tile) (neighbours []*Tile) {
 	currentHeight := current.height
-	for _, side := range sides {
-		checkPoint := Point{current.pos.x + side.x, current.pos.y + side.y}
-		if checkPoint.x < 0 || checkPoint.x >= len(land) || checkPoint.y < 0 || checkPoint.y >= len(land[0]) {
-			continue
-		}
-		neighbour := land[checkPoint.x][checkPoint.y]
+	for _, coord := range land.Neighbors4(current.pos.x, current.pos.y) {
+		neighbour := land.Get(coord[0], coord[1])
 		if neighbour.visited || neighbour.height > currentHeight+1 {
 			continue
 		}
@@ -91,26 +84,22 @@ func getNeighbours(land [][]*Tile, current *Tile) (neighbours []*Tile) {
 	return
 }
 
-func getLowestTile(land [][]*Tile) *Tile {
+func getLowestTile(land *grid.Grid[*Tile]) *Tile {
 	var minimum *Tile
-	for _, row := range land {
-		for _, tile := range row {
-			if (minimum == nil || tile.tentativeDistance < minimum.tentativeDistance) && !tile.visited {
-				minimum = tile
-			}
+	land.Each(func(_, _ int, tile *Tile) {
+		if (minimum == nil || tile.tentativeDistance < minimum.tentativeDistance) && !tile.visited {
+			minimum = tile
 		}
-	}
+	})
 	return minimum
 }
 
-func resetLand(land [][]*Tile, start Point) {
-	for _, row := range land {
-		for _, tile := range row {
-			tile.tentativeDistance = MAX_DISTANCE
-			tile.visited = false
-		}
-	}
-	land[start.x][start.y].tentativeDistance = 0
+func resetLand(land *grid.Grid[*Tile], start Point) {
+	land.Each(func(_, _ int, tile *Tile) {
+		tile.tentativeDistance = MAX_DISTANCE
+		tile.visited = false
+	})
+	land.Get(start.x, start.y).tentativeDistance = 0
 }
 
 func part1(input string) int {
@@ -119,7 +108,7 @@ func part1(input string) int {
 	resetLand(land, start)
 	findShortestPath(land, start, goal)
 
-	return land[goal.x][goal.y].tentativeDistance
+	return land.Get(goal.x, goal.y).tentativeDistance
 }
 
 func part2(input string) int {
@@ -127,43 +116,37 @@ func part2(input string) int {
 
 	// Better to switch start and goal, only one calculation!
 	shortestPath := MAX_DISTANCE
-	for _, row := range land {
-		for _, tile := range row {
-			if tile.height == 0 {
-				resetLand(land, tile.pos)
-				findShortestPath(land, tile.pos, goal)
-				if land[goal.x][goal.y].tentativeDistance < shortestPath {
-					shortestPath = land[goal.x][goal.y].tentativeDistance
-				}
+	land.Each(func(_, _ int, tile *Tile) {
+		if tile.height == 0 {
+			resetLand(land, tile.pos)
+			findShortestPath(land, tile.pos, goal)
+			if land.Get(goal.x, goal.y).tentativeDistance < shortestPath {
+				shortestPath = land.Get(goal.x, goal.y).tentativeDistance
 			}
 		}
-	}
+	})
 
 	return shortestPath
 }
 
-func parseInput(input string) (ans [][]*Tile, start, goal Point) {
-	for x, line := range strings.Split(input, "\n") {
-		lineSplit := strings.Split(line, "")
-		row := []*Tile{}
-		for y, char := range lineSplit {
-			tile := &Tile{
-				pos:     Point{x, y},
-				visited: false,
-			}
-			if char == "S" {
-				start.x = x
-				start.y = y
-				char = "a"
-			} else if char == "E" {
-				goal.x = x
-				goal.y = y
-				char = "z"
-			}
-			tile.height = cast.ToASCIICode(char) - cast.ASCIICodeLowerA
-			row = append(row, tile)
+func parseInput(input string) (land *grid.Grid[*Tile], start, goal Point) {
+	chars := grid.MustParseRunes(input)
+	start.x, start.y, _ = chars.Find('S')
+	goal.x, goal.y, _ = chars.Find('E')
+
+	land = grid.New[*Tile](chars.Height(), chars.Width())
+	chars.Each(func(x, y int, char rune) {
+		switch char {
+		case 'S':
+			char = 'a'
+		case 'E':
+			char = 'z'
 		}
-		ans = append(ans, row)
-	}
-	return ans, start, goal
+		land.Set(x, y, &Tile{
+			pos:     Point{x, y},
+			height:  cast.ToASCIICode(char) - cast.ASCIICodeLowerA,
+			visited: false,
+		})
+	})
+	return land, start, goal
 }
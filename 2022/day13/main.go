@@ -123,24 +123,37 @@ func part2(input string) int {
 
 func parseInput(input string) (ans [][2]*Num) {
 	lineSplit := strings.Split(input, "\n")
-	for it := 0; it < len(lineSplit); it += 3 {
-		num1 := parseLine(lineSplit[it])
-		num2 := parseLine(lineSplit[it+1])
+	for it := 0; it+1 < len(lineSplit); it += 3 {
+		num1 := mustParseLine(lineSplit, it)
+		num2 := mustParseLine(lineSplit, it+1)
 		ans = append(ans, [2]*Num{num1, num2})
 	}
 	return ans
 }
 
 func parseInputPart2(input string) (ans []*Num) {
-	for _, line := range strings.Split(input, "\n") {
+	lineSplit := strings.Split(input, "\n")
+	for it, line := range lineSplit {
 		if line != "" {
-			ans = append(ans, parseLine(line))
+			ans = append(ans, mustParseLine(lineSplit, it))
 		}
 	}
 	return ans
 }
 
-func parseLine(line string) *Num {
+// mustParseLine parses lines[it] and panics with the line number on errors
+func mustParseLine(lines []string, it int) *Num {
+	num, err := parseLine(lines[it])
+	if err != nil {
+		panic(fmt.Errorf("line %d %q: %w", it+1, lines[it], err))
+	}
+	return num
+}
+
+func parseLine(line string) (*Num, error) {
+	if len(line) < 2 || line[0] != '[' || line[len(line)-1] != ']' {
+		return nil, fmt.Errorf("packet is not a list")
+	}
 	// Remove outermost array as we'll start with a "hardcoded" root
 	line = line[1 : len(line)-1]
 
@@ -150,21 +163,37 @@ func parseLine(line string) *Num {
 	current := root
 	numCache := ""
 
-	for _, char := range line {
+	// flushNum adds the number in the cache to current, col is the index of
+	// the char ending the number, +2 makes it 1-based in the unstripped line
+	flushNum := func(col int) error {
+		if numCache == "" {
+			return nil
+		}
+		plain, err := cast.Parse[int](numCache)
+		if err != nil {
+			return fmt.Errorf("column %d: %w", col+2, err)
+		}
+		current.arr = append(current.arr, &Num{parent: current, plain: plain})
+		numCache = ""
+		return nil
+	}
+
+	for col, char := range line {
 		switch char {
 		case ',':
-			if numCache != "" {
-				current.arr = append(current.arr, &Num{parent: current, plain: cast.ToInt(numCache)})
-				numCache = ""
+			if err := flushNum(col); err != nil {
+				return nil, err
 			}
 		case '[':
 			newNum := &Num{parent: current, arr: []*Num{}}
 			current.arr = append(current.arr, newNum)
 			current = newNum
 		case ']':
-			if numCache != "" {
-				current.arr = append(current.arr, &Num{parent: current, plain: cast.ToInt(numCache)})
-				numCache = ""
+			if err := flushNum(col); err != nil {
+				return nil, err
+			}
+			if current == root {
+				return nil, fmt.Errorf("column %d: unmatched ]", col+2)
 			}
 			current = current.parent
 		default:
@@ -173,11 +202,13 @@ func parseLine(line string) *Num {
 			numCache += string(char)
 		}
 	}
+	if current != root {
+		return nil, fmt.Errorf("unclosed [")
+	}
 
 	// We remove the outermost parantheses, if the last char is a number we therefore won't save it
-	if numCache != "" {
-		root.arr = append(root.arr, &Num{parent: nil, plain: cast.ToInt(numCache)})
+	if err := flushNum(len(line)); err != nil {
+		return nil, err
 	}
-
-	return root
+	return root, nil
 }
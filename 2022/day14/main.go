@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/grid"
+	"github.com/mheidinger/advent-of-code-go/mathy"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -57,15 +59,8 @@ func (point Point) Add(point2 Point) Point {
 	}
 }
 
-func Abs(x int) int {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
 func (point Point) Len() int {
-	return Abs(point.x + point.y)
+	return mathy.Abs(point.x + point.y)
 }
 
 type Material string
@@ -77,46 +72,32 @@ const (
 	MatSpawn = "+"
 )
 
-func getEmptyScene(low, high Point) [][]Material {
-	rangeY := high.y - low.y
-
-	scene := make([][]Material, 0, high.x+1)
-	for x := 0; x <= high.x+3; x++ {
-		row := make([]Material, 0, rangeY+1)
-		for y := 0; y <= rangeY; y++ {
-			row = append(row, MatAir)
-		}
-		scene = append(scene, row)
-	}
-
+func getEmptyScene(low, high Point) *grid.Grid[Material] {
+	scene := grid.New[Material](high.x+4, high.y-low.y+1)
+	scene.Each(func(x, y int, _ Material) {
+		scene.Set(x, y, MatAir)
+	})
 	return scene
 }
 
-func insertRocks(scene [][]Material, lines [][]Point) {
+func insertRocks(scene *grid.Grid[Material], lines [][]Point) {
 	for _, line := range lines {
 		drawLine(scene, line)
 	}
 }
 
-func sign(in int) int {
-	if in < 0 {
-		return -1
-	}
-	return 1
-}
-
-func drawLine(scene [][]Material, line []Point) {
+func drawLine(scene *grid.Grid[Material], line []Point) {
 	for it := 0; it < len(line)-1; it++ {
 		point1 := line[it]
 		point2 := line[it+1]
 		diff := point2.Sub(point1)
 		for step := 0; step <= diff.Len(); step++ {
-			if Abs(diff.x) > 0 {
-				x := point1.x + (step * sign(diff.x))
-				scene[x][point1.y] = MatRock
+			if mathy.Abs(diff.x) > 0 {
+				x := point1.x + (step * mathy.Sign(diff.x))
+				scene.Set(x, point1.y, MatRock)
 			} else {
-				y := point1.y + (step * sign(diff.y))
-				scene[point1.x][y] = MatRock
+				y := point1.y + (step * mathy.Sign(diff.y))
+				scene.Set(point1.x, y, MatRock)
 			}
 		}
 	}
@@ -132,61 +113,54 @@ func scaleLinesY(lines [][]Point, low Point) [][]Point {
 	return lines
 }
 
-func drawScene(scene [][]Material) {
-	for _, row := range scene {
-		for _, pixel := range row {
-			fmt.Print(pixel)
-		}
-		fmt.Println()
-	}
+func drawScene(scene *grid.Grid[Material]) {
+	fmt.Println(scene)
 }
 
-func simulateSand(scene [][]Material, spawn Point) (spawnedSand int) {
-	defer func() {
-		if err := recover(); err != nil {
-			fmt.Println(err)
-		}
-	}()
-
+// dropSand lets one unit of sand fall from spawn until it rests, it returns
+// false if the sand falls out of the scene instead
+func dropSand(scene *grid.Grid[Material], spawn Point) (Point, bool) {
+	sandPos := spawn
 	for {
-		sandPos := spawn
-		for {
-			if scene[sandPos.x+1][sandPos.y] == MatAir {
-				sandPos = sandPos.Add(Point{1, 0})
-			} else if scene[sandPos.x+1][sandPos.y-1] == MatAir {
-				sandPos = sandPos.Add(Point{1, -1})
-			} else if scene[sandPos.x+1][sandPos.y+1] == MatAir {
-				sandPos = sandPos.Add(Point{1, 1})
-			} else {
+		moved := false
+		for _, dir := range []Point{{1, 0}, {1, -1}, {1, 1}} {
+			next := sandPos.Add(dir)
+			if !scene.In(next.x, next.y) {
+				return sandPos, false
+			}
+			if scene.Get(next.x, next.y) == MatAir {
+				sandPos = next
+				moved = true
 				break
 			}
 		}
-		scene[sandPos.x][sandPos.y] = MatSand
+		if !moved {
+			scene.Set(sandPos.x, sandPos.y, MatSand)
+			return sandPos, true
+		}
+	}
+}
+
+func simulateSand(scene *grid.Grid[Material], spawn Point) (spawnedSand int) {
+	for {
+		if _, rests := dropSand(scene, spawn); !rests {
+			return spawnedSand
+		}
 		spawnedSand++
 	}
 }
 
-func simulateSandPart2(scene [][]Material, spawn Point) (spawnedSand int) {
+func simulateSandPart2(scene *grid.Grid[Material], spawn Point) (spawnedSand int) {
 	for {
-		sandPos := spawn
-		for {
-			if scene[sandPos.x+1][sandPos.y] == MatAir {
-				sandPos = sandPos.Add(Point{1, 0})
-			} else if scene[sandPos.x+1][sandPos.y-1] == MatAir {
-				sandPos = sandPos.Add(Point{1, -1})
-			} else if scene[sandPos.x+1][sandPos.y+1] == MatAir {
-				sandPos = sandPos.Add(Point{1, 1})
-			} else {
-				break
-			}
+		sandPos, rests := dropSand(scene, spawn)
+		if !rests {
+			panic("sand fell out of the scene, increase the margin")
 		}
-		scene[sandPos.x][sandPos.y] = MatSand
 		spawnedSand++
 		if sandPos == spawn {
-			break
+			return spawnedSand
 		}
 	}
-	return spawnedSand
 }
 
 func part1(input string) int {
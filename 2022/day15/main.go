@@ -1,13 +1,18 @@
 package main
 
 import (
+	"context"
 	_ "embed"
 	"flag"
 	"fmt"
 	"regexp"
 	"strings"
 
-	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/concurrency"
+	"github.com/mheidinger/advent-of-code-go/data-structures/set"
+	"github.com/mheidinger/advent-of-code-go/geom"
+	"github.com/mheidinger/advent-of-code-go/mathy"
+	"github.com/mheidinger/advent-of-code-go/parse"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -39,122 +44,80 @@ func main() {
 	}
 }
 
-type Point struct {
-	x int
-	y int
-}
-
-func (point Point) Sub(point2 Point) Point {
-	return Point{
-		point.x - point2.x,
-		point.y - point2.y,
-	}
-}
-
-func (point Point) Add(point2 Point) Point {
-	return Point{
-		point.x + point2.x,
-		point.y + point2.y,
-	}
-}
-
-func (point Point) ManhattanDistance(point2 Point) int {
-	return Abs(point.x-point2.x) + Abs(point.y-point2.y)
-}
-
-func Abs(x int) int {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
 type Sensor struct {
-	pos        Point
-	beacon     Point
+	pos        geom.Vec2
+	beacon     geom.Vec2
 	distBeacon int
 }
 
-func isCovered(sensors []*Sensor, point Point, beaconRet bool) bool {
-	covered := false
-	for _, sens := range sensors {
-		if sens.beacon == point {
-			return beaconRet
-		}
-
-		if sens.pos.ManhattanDistance(point) <= sens.distBeacon {
-			covered = true
-		}
-	}
-	return covered
-}
-
-func isCoveredPart2(sensors []*Sensor, point Point) (bool, int) {
+// rowCoverage returns the x ranges in the given row that are within the
+// beacon distance of any sensor
+func rowCoverage(sensors []*Sensor, y int) *set.IntervalSet {
+	coverage := set.NewIntervalSet()
 	for _, sens := range sensors {
-		pointDistance := sens.pos.ManhattanDistance(point)
-		if pointDistance <= sens.distBeacon {
-			skipY := Abs(sens.distBeacon - pointDistance)
-			return true, skipY
+		reach := sens.distBeacon - mathy.AbsInt(sens.pos.Y-y)
+		if reach >= 0 {
+			coverage.Add(set.Closed(sens.pos.X-reach, sens.pos.X+reach))
 		}
 	}
-	return false, 0
+	return coverage
 }
 
 func part1(input string, y int) int {
-	sensors, minX, maxX := parseInput(input)
+	sensors := parseInput(input)
+	coverage := rowCoverage(sensors, y)
 
-	covered := 0
-	for it := minX - 50; it <= maxX+50; it++ {
-		point := Point{it, y}
-		if isCovered(sensors, point, false) {
-			covered++
+	// beacons in that row are covered, but obviously can be a beacon
+	beacons := set.NewIntSet(nil)
+	for _, sens := range sensors {
+		if sens.beacon.Y == y && coverage.Contains(sens.beacon.X) {
+			beacons.Add(sens.beacon.X)
 		}
 	}
 
-	return covered
+	return coverage.Len() - len(beacons)
 }
 
 func part2(input string, searchMax int) int {
-	sensors, _, _ := parseInput(input)
-
-	for x := 0; x <= searchMax; x++ {
-		for y := 0; y <= searchMax; y++ {
-			point := Point{x, y}
-			covered, skipY := isCoveredPart2(sensors, point)
-			if !covered {
-				return x*4000000 + y
+	sensors := parseInput(input)
+
+	// rows are independent, so check them concurrently
+	frequency, _, found, err := concurrency.FirstInRange(context.Background(), 0, searchMax+1, 0,
+		func(_ context.Context, y int) (int, bool, error) {
+			gaps := rowCoverage(sensors, y).Gaps(set.Closed(0, searchMax))
+			if len(gaps) == 0 {
+				return 0, false, nil
 			}
-			y += skipY
-			// fmt.Printf("checked point %v, skip %d\n", point, skipY)
-		}
+			return gaps[0].Start*4000000 + y, true, nil
+		})
+	if err != nil {
+		panic(err)
 	}
-
-	return -1
+	if !found {
+		return -1
+	}
+	return frequency
 }
 
-var reg = regexp.MustCompile(`.*x=(-?\d+), y=(-?\d+).*x=(-?\d+), y=(-?\d+)`)
+var reg = regexp.MustCompile(`Sensor at x=(?P<SensorX>-?\d+), y=(?P<SensorY>-?\d+): closest beacon is at x=(?P<BeaconX>-?\d+), y=(?P<BeaconY>-?\d+)`)
+
+type sensorLine struct {
+	SensorX, SensorY int
+	BeaconX, BeaconY int
+}
 
-func parseInput(input string) (ans []*Sensor, minX, maxX int) {
-	maxDistBeacon := 0
-	minX = 99999999999999999
-	maxX = 0
-	for _, line := range strings.Split(input, "\n") {
-		matches := reg.FindStringSubmatch(line)
+func parseInput(input string) (ans []*Sensor) {
+	lines, err := parse.Regexp[sensorLine](reg, input)
+	if err != nil {
+		panic(err)
+	}
+	for _, line := range lines {
 		sens := &Sensor{
-			pos:    Point{cast.ToInt(matches[1]), cast.ToInt(matches[2])},
-			beacon: Point{cast.ToInt(matches[3]), cast.ToInt(matches[4])},
+			pos:    geom.Vec2{X: line.SensorX, Y: line.SensorY},
+			beacon: geom.Vec2{X: line.BeaconX, Y: line.BeaconY},
 		}
-		sens.distBeacon = sens.pos.ManhattanDistance(sens.beacon)
+		sens.distBeacon = sens.pos.Manhattan(sens.beacon)
 		ans = append(ans, sens)
-
-		if Abs(sens.distBeacon) > maxDistBeacon {
-			maxDistBeacon = Abs(sens.distBeacon)
-		}
-		if sens.pos.x < minX {
-			minX = sens.pos.x
-		} else if sens.pos.x > maxX {
-			maxX = sens.pos.x
-		}
 	}
-	return ans, minX - maxDistBeacon, maxX + maxDistBeacon
+	return ans
 }
@@ -7,9 +7,10 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/bitset"
+	"github.com/mheidinger/advent-of-code-go/parse"
+	"github.com/mheidinger/advent-of-code-go/search"
 	"github.com/mheidinger/advent-of-code-go/util"
-	"golang.org/x/exp/slices"
 )
 
 //go:embed input.txt
@@ -111,49 +112,67 @@ func getNextValve(valves map[string]*Valve, currValve string, targetValves []str
 	return valve.id, valve.distance, pressureRelief
 }
 
-type Path struct {
-	valves         []string
-	pressureRelief int
+// valveState is a node in the search for the best order to open the valves
+type valveState struct {
+	valve    *Valve
+	closed   bitset.Bits64
+	timeLeft int
+	released int
 }
 
-func getMaxPressureRelief(valves map[string]*Valve, currentValve *Valve, targetValves []*Valve, timeLeft int) []*Path {
-	paths := []*Path{}
-
-	if len(targetValves) == 0 {
-		paths = append(paths, &Path{
-			valves:         []string{},
-			pressureRelief: 0,
-		})
-		return paths
-	}
+type valveKey struct {
+	valve  string
+	closed bitset.Bits64
+}
 
-	for it, targetValve := range targetValves {
-		dist := currentValve.distances[targetValve.id]
-		newTimeLeft := timeLeft - dist - 1
-		if newTimeLeft <= 0 {
-			paths = append(paths, &Path{
-				valves:         []string{},
-				pressureRelief: 0,
+// pressureProblem searches for the most pressure released, the bits of
+// closed are the indices into targets
+func pressureProblem(targets []*Valve) search.Problem[valveState, valveKey] {
+	return search.Problem[valveState, valveKey]{
+		Successors: func(state valveState) []valveState {
+			next := []valveState{}
+			state.closed.Each(func(it int) bool {
+				target := targets[it]
+				timeLeft := state.timeLeft - state.valve.distances[target.id] - 1
+				if timeLeft > 0 {
+					next = append(next, valveState{
+						valve:    target,
+						closed:   state.closed.Clear(it),
+						timeLeft: timeLeft,
+						released: state.released + target.flowRate*timeLeft,
+					})
+				}
+				return true
 			})
-			continue
-		}
-		pressureRelief := targetValve.flowRate * newTimeLeft
-		reducedTargetValves := slices.Delete(slices.Clone(targetValves), it, it+1)
-		nestedPaths := getMaxPressureRelief(valves, targetValve, reducedTargetValves, newTimeLeft)
-		for _, nestedPath := range nestedPaths {
-			valves := []string{targetValve.id}
-			paths = append(paths, &Path{
-				valves:         append(valves, nestedPath.valves...),
-				pressureRelief: nestedPath.pressureRelief + pressureRelief,
+			return next
+		},
+		Score: func(state valveState) int {
+			return state.released
+		},
+		// as if every closed valve could be reached directly from the current one
+		UpperBound: func(state valveState) int {
+			bound := state.released
+			state.closed.Each(func(it int) bool {
+				timeLeft := state.timeLeft - state.valve.distances[targets[it].id] - 1
+				if timeLeft > 0 {
+					bound += targets[it].flowRate * timeLeft
+				}
+				return true
 			})
-		}
+			return bound
+		},
+		Key: func(state valveState) valveKey {
+			return valveKey{state.valve.id, state.closed}
+		},
+		Dominates: func(a, b valveState) bool {
+			return a.timeLeft >= b.timeLeft && a.released >= b.released
+		},
 	}
-	return paths
 }
 
-func part1(input string) int {
-	valves := parseInput(input)
-
+// prepareValves calculates the distances between all valves and returns the
+// ones worth opening, their indices are used as bits in the valve sets
+func prepareValves(valves map[string]*Valve) []*Valve {
 	targetValves := []*Valve{}
 	for _, valve := range valves {
 		setValveDistances(valves, valve)
@@ -161,125 +180,98 @@ func part1(input string) int {
 			targetValves = append(targetValves, valve)
 		}
 	}
-
-	paths := getMaxPressureRelief(valves, valves["AA"], targetValves, 30)
-
-	pressureRelief := 0
-	for _, path := range paths {
-		if path.pressureRelief > pressureRelief {
-			pressureRelief = path.pressureRelief
-		}
+	if len(targetValves) > 64 {
+		panic(fmt.Sprintf("%d valves with flow do not fit into a valve set", len(targetValves)))
 	}
-
-	return pressureRelief
+	return targetValves
 }
 
-func nextProduct(a []*Path, r int) func() []*Path {
-	p := make([]*Path, r)
-	x := make([]int, len(p))
-	return func() []*Path {
-		p := p[:len(x)]
-		for i, xi := range x {
-			p[i] = a[xi]
-		}
-		for i := len(x) - 1; i >= 0; i-- {
-			x[i]++
-			if x[i] < len(a) {
-				break
-			}
-			x[i] = 0
-			if i <= 0 {
-				x = x[0:0]
-				break
-			}
-		}
-		return p
+// allValves returns the set of all target valves
+func allValves(targets []*Valve) (all bitset.Bits64) {
+	for it := range targets {
+		all = all.Set(it)
 	}
+	return all
 }
 
-func getPathKey(valves []string) string {
-	keyBuilder := strings.Builder{}
-	for _, valve := range valves {
-		keyBuilder.WriteString(valve)
-	}
-	return keyBuilder.String()
+func part1(input string) int {
+	valves := parseInput(input)
+	targetValves := prepareValves(valves)
+
+	best := search.DFS(pressureProblem(targetValves), valveState{
+		valve:    valves["AA"],
+		closed:   allValves(targetValves),
+		timeLeft: 30,
+	})
+	return best.Score
 }
 
-func getInvertTargetValves(valves []*Valve, used []string) []*Valve {
-	clone := slices.Clone(valves)
-	for _, usedValve := range used {
-		for it, valve := range clone {
-			if valve.id == usedValve {
-				clone = slices.Delete(clone, it, it+1)
-				break
-			}
-		}
+// bestPerOpened records the most pressure released for every set of valves
+// that can be opened in time, by trying every order
+func bestPerOpened(targets []*Valve, state valveState, opened bitset.Bits64, best map[bitset.Bits64]int) {
+	if state.released > best[opened] {
+		best[opened] = state.released
 	}
-	return clone
+	state.closed.Each(func(it int) bool {
+		target := targets[it]
+		timeLeft := state.timeLeft - state.valve.distances[target.id] - 1
+		if timeLeft > 0 {
+			bestPerOpened(targets, valveState{
+				valve:    target,
+				closed:   state.closed.Clear(it),
+				timeLeft: timeLeft,
+				released: state.released + target.flowRate*timeLeft,
+			}, opened.Set(it), best)
+		}
+		return true
+	})
 }
 
 func part2(input string) int {
 	valves := parseInput(input)
+	targetValves := prepareValves(valves)
 
-	targetValves := []*Valve{}
-	for _, valve := range valves {
-		setValveDistances(valves, valve)
-		if valve.flowRate > 0 {
-			targetValves = append(targetValves, valve)
-		}
-	}
-
-	paths := getMaxPressureRelief(valves, valves["AA"], targetValves, 26)
-
-	pathMap := make(map[string]*Path, len(paths))
-	for _, path := range paths {
-		key := getPathKey(path.valves)
-		pathMap[key] = path
-	}
+	best := map[bitset.Bits64]int{}
+	bestPerOpened(targetValves, valveState{
+		valve:    valves["AA"],
+		closed:   allValves(targetValves),
+		timeLeft: 26,
+	}, 0, best)
 
+	// me and the elephant have to open disjoint sets of valves
 	pressureRelief := 0
-	for {
-		fmt.Println(len(pathMap))
-		var path *Path
-		var key string
-		for selKey, selPath := range pathMap {
-			path = selPath
-			key = selKey
-			break
-		}
-		fmt.Println(path.valves)
-		invertValves := getInvertTargetValves(targetValves, path.valves)
-		fmt.Println(invertValves)
-		complementPaths := getMaxPressureRelief(valves, valves["AA"], invertValves, 26)
-		fmt.Println(len(complementPaths))
-		for _, compPath := range complementPaths {
-			if path.pressureRelief+compPath.pressureRelief > pressureRelief {
-				pressureRelief = path.pressureRelief + compPath.pressureRelief
+	for mine, myRelief := range best {
+		for elephants, elephantRelief := range best {
+			if mine.Disjoint(elephants) && myRelief+elephantRelief > pressureRelief {
+				pressureRelief = myRelief + elephantRelief
 			}
-			// delete(pathMap, getPathKey(compPath.valves))
-		}
-		delete(pathMap, key)
-		if len(pathMap) == 0 {
-			break
 		}
 	}
 
 	return pressureRelief
 }
 
-var reg = regexp.MustCompile(`Valve (..) has flow rate=(\d+); tunnels? leads? to valves? (.*)`)
+var reg = regexp.MustCompile(`Valve (?P<ID>..) has flow rate=(?P<Rate>\d+); tunnels? leads? to valves? (?P<Tunnels>.*)`)
+
+type valveLine struct {
+	ID      string
+	Rate    int
+	Tunnels []string `sep:", "`
+}
 
 func parseInput(input string) (ans map[string]*Valve) {
+	lines, err := parse.Regexp[valveLine](reg, input)
+	if err != nil {
+		panic(err)
+	}
 	ans = map[string]*Valve{}
-	for _, line := range strings.Split(input, "\n") {
-		matches := reg.FindStringSubmatch(line)
-		valve := &Valve{
-			id:        matches[1],
-			flowRate:  cast.ToInt(matches[2]),
-			tunnels:   strings.Split(matches[3], ", "),
+	for _, line := range lines {
+		ans[line.ID] = &Valve{
+			id:        line.ID,
+			flowRate:  line.Rate,
+			tunnels:   line.Tunnels,
 			distances: map[string]int{},
 		}
-		ans[valve.id] = valve
 	}
 	return ans
 }
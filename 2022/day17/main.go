@@ -1,12 +1,13 @@
 package main
 
 import (
-	"crypto/sha256"
 	_ "embed"
 	"flag"
 	"fmt"
 	"strings"
 
+	"github.com/mheidinger/advent-of-code-go/algos"
+	"github.com/mheidinger/advent-of-code-go/data-structures/bitset"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -49,14 +50,14 @@ type Position struct {
 }
 
 type Rock interface {
-	CheckCollission(chamber [][]bool, newPos Position) bool
-	MarkSolid(chamber [][]bool)
+	CheckCollission(chamber *Chamber, newPos Position) bool
+	MarkSolid(chamber *Chamber)
 	GetPosition() Position
 	SetPosition(pos Position)
 	GetHeight() int
 }
 
-func MoveLeft(chamber [][]bool, rock Rock) {
+func MoveLeft(chamber *Chamber, rock Rock) {
 	pos := rock.GetPosition()
 	newPos := Position{height: pos.height, x: pos.x - 1}
 	if !rock.CheckCollission(chamber, newPos) {
@@ -64,7 +65,7 @@ func MoveLeft(chamber [][]bool, rock Rock) {
 	}
 }
 
-func MoveRight(chamber [][]bool, rock Rock) {
+func MoveRight(chamber *Chamber, rock Rock) {
 	pos := rock.GetPosition()
 	newPos := Position{height: pos.height, x: pos.x + 1}
 	if !rock.CheckCollission(chamber, newPos) {
@@ -72,7 +73,7 @@ func MoveRight(chamber [][]bool, rock Rock) {
 	}
 }
 
-func MoveDown(chamber [][]bool, rock Rock) bool {
+func MoveDown(chamber *Chamber, rock Rock) bool {
 	pos := rock.GetPosition()
 	newPos := Position{height: pos.height - 1, x: pos.x}
 	if !rock.CheckCollission(chamber, newPos) {
@@ -82,10 +83,34 @@ func MoveDown(chamber [][]bool, rock Rock) bool {
 	return false
 }
 
-func getRowString(row []bool) string {
+const chamberWidth = 7
+
+// Chamber stores the solid cells row by row in a growable bitset
+type Chamber struct {
+	cells *bitset.Bitset
+}
+
+func newChamber() *Chamber {
+	return &Chamber{cells: bitset.NewGrowable()}
+}
+
+// Solid reports whether the cell is blocked, the walls and floor are solid
+func (chamber *Chamber) Solid(height, x int) bool {
+	if x < 0 || x >= chamberWidth || height < 0 {
+		return true
+	}
+	return chamber.cells.Test(height*chamberWidth + x)
+}
+
+// Mark makes the cell solid
+func (chamber *Chamber) Mark(height, x int) {
+	chamber.cells.Set(height*chamberWidth + x)
+}
+
+func (chamber *Chamber) rowString(height int) string {
 	builder := strings.Builder{}
-	for _, block := range row {
-		if block {
+	for x := 0; x < chamberWidth; x++ {
+		if chamber.Solid(height, x) {
 			builder.WriteString("#")
 		} else {
 			builder.WriteString(".")
@@ -94,88 +119,90 @@ func getRowString(row []bool) string {
 	return builder.String()
 }
 
-func drawChamber(chamber [][]bool, lastHeight int) {
+func drawChamber(chamber *Chamber, lastHeight int) {
 	for height := lastHeight; height >= 0; height-- {
-		fmt.Printf("|%s|\n", getRowString(chamber[height]))
+		fmt.Printf("|%s|\n", chamber.rowString(height))
 	}
 	fmt.Println("+-------+")
 }
 
-type possibleCycle struct {
-	height   int
-	numRocks int
+type simulation struct {
+	chamber     *Chamber
+	directions  []string
+	rocks       []Rock
+	rockIt      int
+	directionIt int
+	maxHeight   int
 }
 
-func runSimulation(input string, numRocks int) int {
-	directions := parseInput(input)
-
-	chamberHeight := 10000
-	chamberWidth := 7
-	chamber := make([][]bool, 0, chamberHeight)
-	for it := 0; it < chamberHeight; it++ {
-		chamber = append(chamber, make([]bool, chamberWidth))
+func newSimulation(directions []string) *simulation {
+	return &simulation{
+		chamber:    newChamber(),
+		directions: directions,
+		rocks:      []Rock{&RockHorizontal{}, &RockCross{}, &RockCorner{}, &RockVertical{}, &RockSquare{}},
+		maxHeight:  -1,
 	}
+}
 
-	possibleCycles := map[string]possibleCycle{}
-
-	rocks := []Rock{&RockHorizontal{}, &RockCross{}, &RockCorner{}, &RockVertical{}, &RockSquare{}}
-	rockIt := 0
-	directionIt := 0
-	maxHeight := -1
-	heightOffset := 0
-	for it := 0; it < numRocks; it++ {
-		currentRock := rocks[rockIt]
-		spawnHeight := maxHeight + currentRock.GetHeight() + 3
-		currentRock.SetPosition(Position{height: spawnHeight, x: 2})
-
-		for {
-			if directions[directionIt] == DirLeft {
-				MoveLeft(chamber, currentRock)
-			} else {
-				MoveRight(chamber, currentRock)
-			}
-			directionIt = (directionIt + 1) % len(directions)
-			if !MoveDown(chamber, currentRock) {
-				break
-			}
-		}
+// dropRock lets the next rock fall until it comes to rest
+func (sim *simulation) dropRock() *simulation {
+	currentRock := sim.rocks[sim.rockIt]
+	spawnHeight := sim.maxHeight + currentRock.GetHeight() + 3
+	currentRock.SetPosition(Position{height: spawnHeight, x: 2})
 
-		currentRock.MarkSolid(chamber)
-		if currentRock.GetPosition().height > maxHeight {
-			maxHeight = currentRock.GetPosition().height
-		}
-
-		checkEnd := maxHeight
-		checkStart := checkEnd - 10
-		if checkStart < 0 {
-			checkStart = 0
+	for {
+		if sim.directions[sim.directionIt] == DirLeft {
+			MoveLeft(sim.chamber, currentRock)
+		} else {
+			MoveRight(sim.chamber, currentRock)
 		}
-		hashString := strings.Builder{}
-		for it := checkStart; it < checkEnd; it++ {
-			hashString.WriteString(getRowString(chamber[it]))
+		sim.directionIt = (sim.directionIt + 1) % len(sim.directions)
+		if !MoveDown(sim.chamber, currentRock) {
+			break
 		}
-		sha := sha256.Sum256([]byte(hashString.String()))
-		key := fmt.Sprintf("%s,%d,%d", sha, rockIt, directionIt)
-		if found, ok := possibleCycles[key]; ok {
-			cycleNumRocks := it - found.numRocks
-			cycleHeight := maxHeight - found.height
-			if cycleNumRocks < numRocks-it {
-				numCycles := (numRocks - it) / cycleNumRocks
-				fmt.Printf("jump ahead from %d with %d cycles of length %d and height %d\n", it, numCycles, cycleNumRocks, cycleHeight)
-				it += numCycles * cycleNumRocks
-				heightOffset += numCycles * cycleHeight
-			}
-		} else {
-			possibleCycles[key] = possibleCycle{
-				numRocks: it,
-				height:   heightOffset + maxHeight,
+	}
+
+	currentRock.MarkSolid(sim.chamber)
+	if currentRock.GetPosition().height > sim.maxHeight {
+		sim.maxHeight = currentRock.GetPosition().height
+	}
+	sim.rockIt = (sim.rockIt + 1) % len(sim.rocks)
+	return sim
+}
+
+type simulationKey struct {
+	topRows     bitset.Bits128
+	rockIt      int
+	directionIt int
+}
+
+// key approximates the state by the top rows of the chamber, assuming nothing
+// falls further down than that
+func (sim *simulation) key() simulationKey {
+	checkEnd := sim.maxHeight
+	checkStart := checkEnd - 10
+	if checkStart < 0 {
+		checkStart = 0
+	}
+	var topRows bitset.Bits128
+	for it := checkStart; it < checkEnd; it++ {
+		for x := 0; x < chamberWidth; x++ {
+			if sim.chamber.Solid(it, x) {
+				topRows = topRows.Set((it-checkStart)*chamberWidth + x)
 			}
 		}
-
-		rockIt = (rockIt + 1) % len(rocks)
 	}
+	return simulationKey{topRows, sim.rockIt, sim.directionIt}
+}
+
+func (sim *simulation) height() int {
+	return sim.maxHeight + 1
+}
 
-	return heightOffset + maxHeight + 1
+func runSimulation(input string, numRocks int) int {
+	sim := newSimulation(parseInput(input))
+	cycle := algos.FindCycle(sim, (*simulation).dropRock, (*simulation).key, (*simulation).height, numRocks)
+	return cycle.Extrapolate(numRocks)
 }
 
 func part1(input string) int {
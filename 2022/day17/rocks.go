@@ -20,17 +20,11 @@ func (rock *RockHorizontal) GetPosition() Position {
 	return rock.Position
 }
 
-func (rock *RockHorizontal) CheckCollission(chamber [][]bool, newPos Position) (collision bool) {
-	defer func() {
-		if err := recover(); err != nil {
-			collision = true
-		}
-	}()
-
-	if !chamber[newPos.height][newPos.x] &&
-		!chamber[newPos.height][newPos.x+1] &&
-		!chamber[newPos.height][newPos.x+2] &&
-		!chamber[newPos.height][newPos.x+3] {
+func (rock *RockHorizontal) CheckCollission(chamber *Chamber, newPos Position) (collision bool) {
+	if !chamber.Solid(newPos.height, newPos.x) &&
+		!chamber.Solid(newPos.height, newPos.x+1) &&
+		!chamber.Solid(newPos.height, newPos.x+2) &&
+		!chamber.Solid(newPos.height, newPos.x+3) {
 		collision = false
 	} else {
 		collision = true
@@ -38,11 +32,11 @@ func (rock *RockHorizontal) CheckCollission(chamber [][]bool, newPos Position) (
 	return
 }
 
-func (rock *RockHorizontal) MarkSolid(chamber [][]bool) {
-	chamber[rock.height][rock.x] = true
-	chamber[rock.height][rock.x+1] = true
-	chamber[rock.height][rock.x+2] = true
-	chamber[rock.height][rock.x+3] = true
+func (rock *RockHorizontal) MarkSolid(chamber *Chamber) {
+	chamber.Mark(rock.height, rock.x)
+	chamber.Mark(rock.height, rock.x+1)
+	chamber.Mark(rock.height, rock.x+2)
+	chamber.Mark(rock.height, rock.x+3)
 }
 
 // .#.
@@ -67,18 +61,12 @@ func (rock *RockCross) GetPosition() Position {
 	return rock.Position
 }
 
-func (rock *RockCross) CheckCollission(chamber [][]bool, newPos Position) (collision bool) {
-	defer func() {
-		if err := recover(); err != nil {
-			collision = true
-		}
-	}()
-
-	if !chamber[newPos.height][newPos.x+1] &&
-		!chamber[newPos.height-1][newPos.x] &&
-		!chamber[newPos.height-1][newPos.x+1] &&
-		!chamber[newPos.height-1][newPos.x+2] &&
-		!chamber[newPos.height-2][newPos.x+1] {
+func (rock *RockCross) CheckCollission(chamber *Chamber, newPos Position) (collision bool) {
+	if !chamber.Solid(newPos.height, newPos.x+1) &&
+		!chamber.Solid(newPos.height-1, newPos.x) &&
+		!chamber.Solid(newPos.height-1, newPos.x+1) &&
+		!chamber.Solid(newPos.height-1, newPos.x+2) &&
+		!chamber.Solid(newPos.height-2, newPos.x+1) {
 		collision = false
 	} else {
 		collision = true
@@ -86,12 +74,12 @@ func (rock *RockCross) CheckCollission(chamber [][]bool, newPos Position) (colli
 	return
 }
 
-func (rock *RockCross) MarkSolid(chamber [][]bool) {
-	chamber[rock.height][rock.x+1] = true
-	chamber[rock.height-1][rock.x] = true
-	chamber[rock.height-1][rock.x+1] = true
-	chamber[rock.height-1][rock.x+2] = true
-	chamber[rock.height-2][rock.x+1] = true
+func (rock *RockCross) MarkSolid(chamber *Chamber) {
+	chamber.Mark(rock.height, rock.x+1)
+	chamber.Mark(rock.height-1, rock.x)
+	chamber.Mark(rock.height-1, rock.x+1)
+	chamber.Mark(rock.height-1, rock.x+2)
+	chamber.Mark(rock.height-2, rock.x+1)
 }
 
 // ..#
@@ -116,18 +104,12 @@ func (rock *RockCorner) GetPosition() Position {
 	return rock.Position
 }
 
-func (rock *RockCorner) CheckCollission(chamber [][]bool, newPos Position) (collision bool) {
-	defer func() {
-		if err := recover(); err != nil {
-			collision = true
-		}
-	}()
-
-	if !chamber[newPos.height][newPos.x+2] &&
-		!chamber[newPos.height-1][newPos.x+2] &&
-		!chamber[newPos.height-2][newPos.x] &&
-		!chamber[newPos.height-2][newPos.x+1] &&
-		!chamber[newPos.height-2][newPos.x+2] {
+func (rock *RockCorner) CheckCollission(chamber *Chamber, newPos Position) (collision bool) {
+	if !chamber.Solid(newPos.height, newPos.x+2) &&
+		!chamber.Solid(newPos.height-1, newPos.x+2) &&
+		!chamber.Solid(newPos.height-2, newPos.x) &&
+		!chamber.Solid(newPos.height-2, newPos.x+1) &&
+		!chamber.Solid(newPos.height-2, newPos.x+2) {
 		collision = false
 	} else {
 		collision = true
@@ -135,12 +117,12 @@ func (rock *RockCorner) CheckCollission(chamber [][]bool, newPos Position) (coll
 	return
 }
 
-func (rock *RockCorner) MarkSolid(chamber [][]bool) {
-	chamber[rock.height][rock.x+2] = true
-	chamber[rock.height-1][rock.x+2] = true
-	chamber[rock.height-2][rock.x] = true
-	chamber[rock.height-2][rock.x+1] = true
-	chamber[rock.height-2][rock.x+2] = true
+func (rock *RockCorner) MarkSolid(chamber *Chamber) {
+	chamber.Mark(rock.height, rock.x+2)
+	chamber.Mark(rock.height-1, rock.x+2)
+	chamber.Mark(rock.height-2, rock.x)
+	chamber.Mark(rock.height-2, rock.x+1)
+	chamber.Mark(rock.height-2, rock.x+2)
 }
 
 // #
@@ -166,17 +148,11 @@ func (rock *RockVertical) GetPosition() Position {
 	return rock.Position
 }
 
-func (rock *RockVertical) CheckCollission(chamber [][]bool, newPos Position) (collision bool) {
-	defer func() {
-		if err := recover(); err != nil {
-			collision = true
-		}
-	}()
-
-	if !chamber[newPos.height][newPos.x] &&
-		!chamber[newPos.height-1][newPos.x] &&
-		!chamber[newPos.height-2][newPos.x] &&
-		!chamber[newPos.height-3][newPos.x] {
+func (rock *RockVertical) CheckCollission(chamber *Chamber, newPos Position) (collision bool) {
+	if !chamber.Solid(newPos.height, newPos.x) &&
+		!chamber.Solid(newPos.height-1, newPos.x) &&
+		!chamber.Solid(newPos.height-2, newPos.x) &&
+		!chamber.Solid(newPos.height-3, newPos.x) {
 		collision = false
 	} else {
 		collision = true
@@ -184,11 +160,11 @@ func (rock *RockVertical) CheckCollission(chamber [][]bool, newPos Position) (co
 	return
 }
 
-func (rock *RockVertical) MarkSolid(chamber [][]bool) {
-	chamber[rock.height][rock.x] = true
-	chamber[rock.height-1][rock.x] = true
-	chamber[rock.height-2][rock.x] = true
-	chamber[rock.height-3][rock.x] = true
+func (rock *RockVertical) MarkSolid(chamber *Chamber) {
+	chamber.Mark(rock.height, rock.x)
+	chamber.Mark(rock.height-1, rock.x)
+	chamber.Mark(rock.height-2, rock.x)
+	chamber.Mark(rock.height-3, rock.x)
 }
 
 // ##
@@ -212,17 +188,11 @@ func (rock *RockSquare) GetPosition() Position {
 	return rock.Position
 }
 
-func (rock *RockSquare) CheckCollission(chamber [][]bool, newPos Position) (collision bool) {
-	defer func() {
-		if err := recover(); err != nil {
-			collision = true
-		}
-	}()
-
-	if !chamber[newPos.height][newPos.x] &&
-		!chamber[newPos.height][newPos.x+1] &&
-		!chamber[newPos.height-1][newPos.x] &&
-		!chamber[newPos.height-1][newPos.x+1] {
+func (rock *RockSquare) CheckCollission(chamber *Chamber, newPos Position) (collision bool) {
+	if !chamber.Solid(newPos.height, newPos.x) &&
+		!chamber.Solid(newPos.height, newPos.x+1) &&
+		!chamber.Solid(newPos.height-1, newPos.x) &&
+		!chamber.Solid(newPos.height-1, newPos.x+1) {
 		collision = false
 	} else {
 		collision = true
@@ -230,9 +200,9 @@ func (rock *RockSquare) CheckCollission(chamber [][]bool, newPos Position) (coll
 	return
 }
 
-func (rock *RockSquare) MarkSolid(chamber [][]bool) {
-	chamber[rock.height][rock.x] = true
-	chamber[rock.height][rock.x+1] = true
-	chamber[rock.height-1][rock.x] = true
-	chamber[rock.height-1][rock.x+1] = true
+func (rock *RockSquare) MarkSolid(chamber *Chamber) {
+	chamber.Mark(rock.height, rock.x)
+	chamber.Mark(rock.height, rock.x+1)
+	chamber.Mark(rock.height-1, rock.x)
+	chamber.Mark(rock.height-1, rock.x+1)
 }
@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/unionfind"
+	"github.com/mheidinger/advent-of-code-go/geom"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -38,48 +39,10 @@ func main() {
 	}
 }
 
-type Cube struct {
-	x int
-	y int
-	z int
-}
-
-func (cube Cube) Add(cube2 Cube) Cube {
-	return Cube{
-		cube.x + cube2.x,
-		cube.y + cube2.y,
-		cube.z + cube2.z,
-	}
-}
-
-func (cube Cube) Mult(factor int) Cube {
-	return Cube{
-		cube.x * factor,
-		cube.y * factor,
-		cube.z * factor,
-	}
-}
-
-func (cube Cube) toKey() string {
-	return fmt.Sprintf("%d,%d,%d", cube.x, cube.y, cube.z)
-}
-
-func getSides() []Cube {
-	return []Cube{
-		{1, 0, 0},
-		{-1, 0, 0},
-		{0, 1, 0},
-		{0, -1, 0},
-		{0, 0, 1},
-		{0, 0, -1},
-	}
-}
-
-func getOpenSides(cube Cube, cubes map[string]Cube) int {
+func getOpenSides(cube geom.Vec3, cubes map[geom.Vec3]bool) int {
 	openSides := 0
-	for _, side := range getSides() {
-		checkCoord := cube.Add(side)
-		if _, ok := cubes[checkCoord.toKey()]; !ok {
+	for _, neighbour := range cube.Neighbors6() {
+		if !cubes[neighbour] {
 			openSides++
 		}
 	}
@@ -87,39 +50,38 @@ func getOpenSides(cube Cube, cubes map[string]Cube) int {
 	return openSides
 }
 
-func walkExterior(cube Cube, cubes map[string]Cube, checked map[string]bool, min, max Cube) int {
-	// if this coords were already checked, return 0
-	if checked[cube.toKey()] {
-		return 0
-	}
-	// if this coords are out of maximum, return 0 to not discover endlessly
-	if cube.x < min.x || cube.x > max.x || cube.y < min.y || cube.y > max.y || cube.z < min.z || cube.z > max.z {
-		return 0
+// joinAir joins all air cubes within min and max that are connected to
+// each other, min has to be air outside of the droplet
+func joinAir(cubes map[geom.Vec3]bool, min, max geom.Vec3) *unionfind.Sets[geom.Vec3] {
+	inBounds := func(cube geom.Vec3) bool {
+		return cube.X >= min.X && cube.X <= max.X && cube.Y >= min.Y && cube.Y <= max.Y && cube.Z >= min.Z && cube.Z <= max.Z
 	}
-	// if this is part of the lava, we found one side that is exposed
-	// don't check neighbours as we'll only check further on air
-	if _, ok := cubes[cube.toKey()]; ok {
-		return 1
-	}
-
-	// this is air, mark is already as checked to prevent infinite loop
-	// neighbour would check us again, we our neighbour, etc.
-	checked[cube.toKey()] = true
 
-	// check all neighbour coords for their open sides
-	foundCubes := 0
-	for _, side := range getSides() {
-		foundCubes += walkExterior(cube.Add(side), cubes, checked, min, max)
+	air := unionfind.New[geom.Vec3]()
+	for x := min.X; x <= max.X; x++ {
+		for y := min.Y; y <= max.Y; y++ {
+			for z := min.Z; z <= max.Z; z++ {
+				cube := geom.Vec3{X: x, Y: y, Z: z}
+				if cubes[cube] {
+					continue
+				}
+				air.Add(cube)
+				for _, neighbour := range cube.Neighbors6() {
+					if inBounds(neighbour) && !cubes[neighbour] {
+						air.Union(cube, neighbour)
+					}
+				}
+			}
+		}
 	}
-
-	return foundCubes
+	return air
 }
 
 func part1(input string) int {
 	cubes, _ := parseInput(input)
 
 	openSides := 0
-	for _, cube := range cubes {
+	for cube := range cubes {
 		openSides += getOpenSides(cube, cubes)
 	}
 
@@ -129,32 +91,36 @@ func part1(input string) int {
 func part2(input string) int {
 	cubes, max := parseInput(input)
 
-	max = max.Add(Cube{1, 1, 1})
-	min := Cube{-1, -1, -1}
-
-	exterior := make(map[string]bool)
-	return walkExterior(Cube{0, 0, 0}, cubes, exterior, min, max)
+	max = max.Add(geom.Vec3{X: 1, Y: 1, Z: 1})
+	min := geom.Vec3{X: -1, Y: -1, Z: -1}
+
+	// a side is exterior if the air in front of it is connected to min
+	air := joinAir(cubes, min, max)
+	exteriorSides := 0
+	for cube := range cubes {
+		for _, neighbour := range cube.Neighbors6() {
+			if air.Connected(neighbour, min) {
+				exteriorSides++
+			}
+		}
+	}
+	return exteriorSides
 }
 
-func parseInput(input string) (ans map[string]Cube, max Cube) {
-	ans = make(map[string]Cube, 0)
+func parseInput(input string) (ans map[geom.Vec3]bool, max geom.Vec3) {
+	ans = make(map[geom.Vec3]bool, 0)
 	for _, line := range strings.Split(input, "\n") {
-		lineSplit := strings.Split(line, ",")
-		cube := Cube{
-			x: cast.ToInt(lineSplit[0]),
-			y: cast.ToInt(lineSplit[1]),
-			z: cast.ToInt(lineSplit[2]),
-		}
-		ans[line] = cube
+		cube := geom.MustParseVec3(line)
+		ans[cube] = true
 
-		if cube.x > max.x {
-			max.x = cube.x
+		if cube.X > max.X {
+			max.X = cube.X
 		}
-		if cube.y > max.y {
-			max.y = cube.y
+		if cube.Y > max.Y {
+			max.Y = cube.Y
 		}
-		if cube.z > max.z {
-			max.z = cube.z
+		if cube.Z > max.Z {
+			max.Z = cube.Z
 		}
 	}
 	return ans, max
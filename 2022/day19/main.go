@@ -1,15 +1,18 @@
 package main
 
 import (
+	"context"
 	_ "embed"
 	"flag"
 	"fmt"
 	"math"
-	"regexp"
 	"strings"
 
 	"github.com/barkimedes/go-deepcopy"
-	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/concurrency"
+	"github.com/mheidinger/advent-of-code-go/mathy"
+	"github.com/mheidinger/advent-of-code-go/memo"
+	"github.com/mheidinger/advent-of-code-go/parse"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -101,7 +104,8 @@ func (inv Inventory) TimeUntilReached(requiredOre, requiredClay, requiredObsidia
 		timeUntilObsidian = float64(missingObsidian) / float64(inv.Robots[MatObsidian])
 	}
 
-	return int(math.Ceil(MaxFloat(timeUntilOre, timeUntilClay, timeUntilObsidian)))
+	waitTime, _ := mathy.Max(0, timeUntilOre, timeUntilClay, timeUntilObsidian)
+	return int(math.Ceil(waitTime))
 }
 
 func (inv Inventory) PassTime(time int) {
@@ -110,18 +114,31 @@ func (inv Inventory) PassTime(time int) {
 	}
 }
 
-func (bp Blueprint) Simulate(cache map[string]int, inv Inventory, timeLeft int, buildNext string) int {
-	keyBuilder := strings.Builder{}
-	for _, key := range []string{MatOre, MatClay, MatObsidian, MatGeode} {
-		keyBuilder.WriteString(fmt.Sprintf("%d%d", inv.Ores[key], inv.Robots[key]))
-	}
-	keyBuilder.WriteString(fmt.Sprintf("%d", timeLeft))
-	keyBuilder.WriteString(buildNext)
-	key := keyBuilder.String()
+// simulation is the argument of Blueprint.Simulate
+type simulation struct {
+	inv       Inventory
+	timeLeft  int
+	buildNext string
+}
+
+type simulationKey struct {
+	ores, robots [4]int
+	timeLeft     int
+	buildNext    string
+}
 
-	if found, ok := cache[key]; ok {
-		return found
+func (s simulation) key() simulationKey {
+	key := simulationKey{timeLeft: s.timeLeft, buildNext: s.buildNext}
+	for it, mat := range []string{MatOre, MatClay, MatObsidian, MatGeode} {
+		key.ores[it] = s.inv.Ores[mat]
+		key.robots[it] = s.inv.Robots[mat]
 	}
+	return key
+}
+
+// Simulate is memoized by simulation.key, recurse has to be the memoized function
+func (bp Blueprint) Simulate(recurse func(simulation) int, s simulation) int {
+	inv, timeLeft, buildNext := s.inv, s.timeLeft, s.buildNext
 
 	if buildNext != "" {
 		requiredOre, requiredClay, requiredObsidian := bp.GetCosts(buildNext)
@@ -140,14 +157,12 @@ func (bp Blueprint) Simulate(cache map[string]int, inv Inventory, timeLeft int,
 
 	maxGeodes := 0
 	for _, buildNext := range inv.GetPossibleBuilds(bp, timeLeft) {
-		geodes := bp.Simulate(cache, inv.Copy(), timeLeft, buildNext)
+		geodes := recurse(simulation{inv.Copy(), timeLeft, buildNext})
 		if geodes > maxGeodes {
 			maxGeodes = geodes
 		}
 	}
 
-	cache[key] = maxGeodes
-
 	return maxGeodes
 }
 
@@ -173,19 +188,31 @@ func (inv Inventory) GetPossibleBuilds(bp Blueprint, timeLeft int) []string {
 	return builds
 }
 
-func part1(input string) int {
-	blueprints := parseInput(input)
-
-	sumQualityLevel := 0
-	for it, bp := range blueprints {
+// maxGeodes simulates all blueprints concurrently, the results keep the
+// order of the blueprints
+func maxGeodes(blueprints []Blueprint, totalTime int) []int {
+	geodes, err := concurrency.ParallelMap(context.Background(), blueprints, 0, func(_ context.Context, bp Blueprint) (int, error) {
 		initialInv := Inventory{
 			Ores:   map[string]int{MatOre: 0, MatClay: 0, MatObsidian: 0, MatGeode: 0},
 			Robots: map[string]int{MatOre: 1, MatClay: 0, MatObsidian: 0, MatGeode: 0},
 		}
-		totalTime := 24
-		cache := map[string]int{}
-		geodes := bp.Simulate(cache, initialInv, totalTime, "")
+		simulate := memo.MemoizeKey(simulation.key, bp.Simulate)
+		return simulate.Call(simulation{initialInv, totalTime, ""}), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	for it, geodes := range geodes {
 		fmt.Printf("Blueprint #%d: %d geodes\n", it+1, geodes)
+	}
+	return geodes
+}
+
+func part1(input string) int {
+	blueprints := parseInput(input)
+
+	sumQualityLevel := 0
+	for it, geodes := range maxGeodes(blueprints, 24) {
 		sumQualityLevel += geodes * (it + 1)
 	}
 	return sumQualityLevel
@@ -195,57 +222,33 @@ func part2(input string) int {
 	blueprints := parseInput(input)
 
 	prodGeodes := 1
-	for it, bp := range blueprints[:3] {
-		initialInv := Inventory{
-			Ores:   map[string]int{MatOre: 0, MatClay: 0, MatObsidian: 0, MatGeode: 0},
-			Robots: map[string]int{MatOre: 1, MatClay: 0, MatObsidian: 0, MatGeode: 0},
-		}
-		totalTime := 32
-		cache := map[string]int{}
-		geodes := bp.Simulate(cache, initialInv, totalTime, "")
-		fmt.Printf("Blueprint #%d: %d geodes\n", it+1, geodes)
+	for _, geodes := range maxGeodes(blueprints[:3], 32) {
 		prodGeodes *= geodes
 	}
 	return prodGeodes
 }
 
-var reg = regexp.MustCompile(`Blueprint \d+: Each ore robot costs (\d+) ore\. Each clay robot costs (\d+) ore\. Each obsidian robot costs (\d+) ore and (\d+) clay\. Each geode robot costs (\d+) ore and (\d+) obsidian\.`)
-
-func Max(nums ...int) int {
-	max := 0
-	for _, num := range nums {
-		if num > max {
-			max = num
-		}
-	}
-	return max
-}
-
-func MaxFloat(nums ...float64) float64 {
-	max := 0.0
-	for _, num := range nums {
-		if num > max {
-			max = num
-		}
-	}
-	return max
+type blueprintLine struct {
+	OreRobotOre        int `re:"ore robot costs (\\d+) ore"`
+	ClayRobotOre       int `re:"clay robot costs (\\d+) ore"`
+	ObsidianRobotOre   int `re:"obsidian robot costs (\\d+) ore"`
+	ObsidianRobotClay  int `re:"obsidian robot costs \\d+ ore and (\\d+) clay"`
+	GeodeRobotOre      int `re:"geode robot costs (\\d+) ore"`
+	GeodeRobotObsidian int `re:"geode robot costs \\d+ ore and (\\d+) obsidian"`
 }
 
 func parseInput(input string) (ans []Blueprint) {
-	for _, line := range strings.Split(input, "\n") {
-		matches := reg.FindStringSubmatch(line)
+	for _, line := range parse.MustLines[blueprintLine](input) {
 		bp := Blueprint{
-			cast.ToInt(matches[1]),
-			cast.ToInt(matches[2]),
-			cast.ToInt(matches[3]),
-			cast.ToInt(matches[4]),
-			cast.ToInt(matches[5]),
-			cast.ToInt(matches[6]),
-			0,
-			0,
+			oreRobotCostOre:        line.OreRobotOre,
+			clayRobotCostOre:       line.ClayRobotOre,
+			obsidianRobotCostOre:   line.ObsidianRobotOre,
+			obsidianRobotCostClay:  line.ObsidianRobotClay,
+			geodeRobotCostOre:      line.GeodeRobotOre,
+			geodeRobotCostObsidian: line.GeodeRobotObsidian,
 		}
-		bp.maxOreCost = Max(bp.clayRobotCostOre, bp.obsidianRobotCostOre, bp.oreRobotCostOre)
-		bp.maxClayCost = Max(bp.obsidianRobotCostClay)
+		bp.maxOreCost = mathy.MaxInt(bp.clayRobotCostOre, bp.obsidianRobotCostOre, bp.oreRobotCostOre)
+		bp.maxClayCost = mathy.MaxInt(bp.obsidianRobotCostClay)
 		ans = append(ans, bp)
 	}
 	return ans
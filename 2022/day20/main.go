@@ -6,9 +6,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/barkimedes/go-deepcopy"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/ring"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -40,105 +39,39 @@ func main() {
 	}
 }
 
-type Number struct {
-	InitialPos int
-	Num        int
-}
-
-func findNumToMove(numbers []*Number, initialPos int) (int, *Number) {
-	for pos, num := range numbers {
-		if num.InitialPos == initialPos {
-			return pos, num
+// mix moves every number by its value in the original order, for the given
+// number of rounds, and returns the sum of the grove coordinates
+func mix(numbers []int, rounds int) int {
+	list, nodes := ring.NewIndexed(numbers...)
+	for it := 0; it < rounds; it++ {
+		for _, node := range nodes {
+			list.Move(node, node.Value)
 		}
 	}
-	panic(fmt.Errorf("could not find initial pos %d", initialPos))
-}
-
-// 1, 2, -3, 4, 0, 3, -2
 
-func moveNumber(numbers []*Number, num *Number, currentPos int) []*Number {
-	removedList := append(numbers[:currentPos], numbers[currentPos+1:]...)
-	newPos := (currentPos + num.Num) % len(removedList)
-	if newPos < 0 {
-		newPos = len(removedList) + newPos
+	zero := list.Find(func(num int) bool { return num == 0 })
+	if zero == nil {
+		panic("no 0 in the numbers")
 	}
-
-	copy := deepcopy.MustAnything(removedList).([]*Number)
-	newList := append(copy[:newPos], num)
-	return append(newList, removedList[newPos:]...)
-}
-
-func Abs(x int) int {
-	if x < 0 {
-		return -x
-	}
-	return x
+	posZero := list.Index(zero)
+	return list.At(posZero+1000).Value + list.At(posZero+2000).Value + list.At(posZero+3000).Value
 }
 
 func part1(input string) int {
-	numbers := parseInput(input)
-
-	spew.Println(numbers)
-	for it := range numbers {
-		pos, num := findNumToMove(numbers, it)
-		numbers = moveNumber(numbers, num, pos)
-		// spew.Println(numbers)
-	}
-
-	posZero := -1
-	for it, num := range numbers {
-		if num.Num == 0 {
-			posZero = it
-			break
-		}
-	}
-
-	pos1 := (posZero + 1000) % len(numbers)
-	pos2 := (posZero + 2000) % len(numbers)
-	pos3 := (posZero + 3000) % len(numbers)
-	fmt.Printf("%d %d %d\n", pos1, pos2, pos3)
-	fmt.Printf("%d %d %d\n", numbers[pos1].Num, numbers[pos2].Num, numbers[pos3].Num)
-	return numbers[pos1].Num + numbers[pos2].Num + numbers[pos3].Num
+	return mix(parseInput(input), 1)
 }
 
 func part2(input string) int {
 	numbers := parseInput(input)
-
-	for _, num := range numbers {
-		num.Num *= 811589153
-	}
-	spew.Println(numbers)
-	for it := 0; it < 10; it++ {
-		for it := range numbers {
-			pos, num := findNumToMove(numbers, it)
-			numbers = moveNumber(numbers, num, pos)
-			// spew.Println(numbers)
-		}
-	}
-
-	posZero := -1
-	for it, num := range numbers {
-		if num.Num == 0 {
-			posZero = it
-			break
-		}
+	for it := range numbers {
+		numbers[it] *= 811589153
 	}
-
-	pos1 := (posZero + 1000) % len(numbers)
-	pos2 := (posZero + 2000) % len(numbers)
-	pos3 := (posZero + 3000) % len(numbers)
-	fmt.Printf("%d %d %d\n", pos1, pos2, pos3)
-	fmt.Printf("%d %d %d\n", numbers[pos1].Num, numbers[pos2].Num, numbers[pos3].Num)
-	return numbers[pos1].Num + numbers[pos2].Num + numbers[pos3].Num
+	return mix(numbers, 10)
 }
 
-func parseInput(input string) (ans []*Number) {
-	for it, line := range strings.Split(input, "\n") {
-		num := &Number{
-			InitialPos: it,
-			Num:        cast.ToInt(line),
-		}
-		ans = append(ans, num)
+func parseInput(input string) (ans []int) {
+	for _, line := range strings.Split(input, "\n") {
+		ans = append(ans, cast.ToInt(line))
 	}
 	return ans
 }
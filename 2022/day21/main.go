@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/dag"
+	"github.com/mheidinger/advent-of-code-go/mathy"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -39,48 +41,38 @@ func main() {
 }
 
 type Statement struct {
-	Name       string
-	Num        int
-	Operator   string
-	Operand1   string
-	Operand2   string
-	Resolved   bool
-	Statement1 *Statement
-	Statement2 *Statement
-	Human      bool
+	Name     string
+	Num      int
+	Operator string
+	Operand1 string
+	Operand2 string
 }
 
-func Resolve(statementMap map[string]*Statement, name string) *Statement {
-	statement, ok := statementMap[name]
-	if !ok {
-		panic(fmt.Errorf("Unknown name: %s", name))
+func apply(operator string, operand1, operand2 int) int {
+	switch operator {
+	case "*":
+		return operand1 * operand2
+	case "+":
+		return operand1 + operand2
+	case "/":
+		return operand1 / operand2
+	case "-":
+		return operand1 - operand2
 	}
-	if statement.Resolved || statement.Operator == "" || statement.Human {
-		return statement
-	}
-	operand1 := Resolve(statementMap, statement.Operand1)
-	operand2 := Resolve(statementMap, statement.Operand2)
-	if operand1.Operator == "" && operand2.Operator == "" {
-		switch statement.Operator {
-		case "*":
-			statement.Num = operand1.Num * operand2.Num
-		case "+":
-			statement.Num = operand1.Num + operand2.Num
-		case "/":
-			statement.Num = operand1.Num / operand2.Num
-		case "-":
-			statement.Num = operand1.Num - operand2.Num
-		default:
-			panic(fmt.Errorf("Unknown operator %s", statement.Operator))
+	panic(fmt.Errorf("Unknown operator %s", operator))
+}
+
+// buildGraph connects every statement to the statements waiting for it
+func buildGraph(statementMap map[string]*Statement) *dag.Graph[string] {
+	graph := dag.New[string]()
+	for name, statement := range statementMap {
+		graph.AddNode(name)
+		if statement.Operator != "" {
+			graph.AddEdge(statement.Operand1, name)
+			graph.AddEdge(statement.Operand2, name)
 		}
-		statement.Operator = ""
-	} else {
-		statement.Statement1 = operand1
-		statement.Statement2 = operand2
 	}
-
-	statement.Resolved = true
-	return statement
+	return graph
 }
 
 func part1(input string) int {
@@ -91,77 +83,40 @@ func part1(input string) int {
 		statementMap[statement.Name] = statement
 	}
 
-	return Resolve(statementMap, "root").Num
-}
-
-func resetMap(statementMap map[string]*Statement) {
-	for _, statement := range statementMap {
-		statement.Resolved = false
+	root, err := dag.EvaluateNode(buildGraph(statementMap), "root", func(name string, value func(string) int) int {
+		statement, ok := statementMap[name]
+		if !ok {
+			panic(fmt.Errorf("Unknown name: %s", name))
+		}
+		if statement.Operator == "" {
+			return statement.Num
+		}
+		return apply(statement.Operator, value(statement.Operand1), value(statement.Operand2))
+	})
+	if err != nil {
+		panic(err)
 	}
+	return root
 }
 
-func getFixAndSolve(stmt *Statement) (int, *Statement) {
-	fixedNum := -1
-	var solveStmt *Statement
-	if stmt.Statement1.Operator == "" {
-		fixedNum = stmt.Statement1.Num
-		solveStmt = stmt.Statement2
-	} else if stmt.Statement2.Operator == "" {
-		fixedNum = stmt.Statement2.Num
-		solveStmt = stmt.Statement1
-	} else {
-		panic(fmt.Errorf("No fixed num in statement: %+v", stmt))
+// buildExpr converts the statement with the given name into an expression
+// tree, the statement named unknown becomes the unknown of the tree
+func buildExpr(statementMap map[string]*Statement, name, unknown string) *mathy.ExprNode {
+	statement, ok := statementMap[name]
+	if !ok {
+		panic(fmt.Errorf("Unknown name: %s", name))
 	}
-
-	return fixedNum, solveStmt
-}
-
-func SolveToResult(stmt *Statement, want int) int {
-	if stmt.Human {
-		return want
+	if name == unknown {
+		return &mathy.ExprNode{Unknown: true}
 	}
-
-	solveWant := -1
-	var solveStmt *Statement
-
-	if stmt.Statement1.Operator == "" {
-		fixedNum := stmt.Statement1.Num
-		solveStmt = stmt.Statement2
-
-		switch stmt.Operator {
-		case "*":
-			solveWant = want / fixedNum
-		case "+":
-			solveWant = want - fixedNum
-		case "/":
-			solveWant = fixedNum / want
-		case "-":
-			solveWant = fixedNum - want
-		}
-	} else if stmt.Statement2.Operator == "" {
-		fixedNum := stmt.Statement2.Num
-		solveStmt = stmt.Statement1
-
-		switch stmt.Operator {
-		case "*":
-			solveWant = want / fixedNum
-		case "+":
-			solveWant = want - fixedNum
-		case "/":
-			solveWant = want * fixedNum
-		case "-":
-			solveWant = want + fixedNum
-		}
-	} else {
-		panic(fmt.Errorf("No fixed num in statement: %+v", stmt))
+	if statement.Operator == "" {
+		return &mathy.ExprNode{Value: mathy.IntRat(statement.Num)}
+	}
+	return &mathy.ExprNode{
+		Op:    statement.Operator,
+		Left:  buildExpr(statementMap, statement.Operand1, unknown),
+		Right: buildExpr(statementMap, statement.Operand2, unknown),
 	}
-
-	return SolveToResult(solveStmt, solveWant)
-}
-
-func SolveForEquality(stmt *Statement) int {
-	fixedNum, solveStmt := getFixAndSolve(stmt)
-	return SolveToResult(solveStmt, fixedNum)
 }
 
 func part2(input string) int {
@@ -171,26 +126,27 @@ func part2(input string) int {
 	for _, statement := range statements {
 		statementMap[statement.Name] = statement
 	}
-	statementMap["humn"].Human = true
-	statementMap["humn"].Operator = "human"
 
-	stmt := Resolve(statementMap, "root")
-
-	human := SolveForEquality(stmt)
-
-	resetMap(statementMap)
-	statementMap["humn"].Human = false
-	statementMap["humn"].Operator = ""
-	statementMap["humn"].Num = human
-
-	statementMap["root"].Operator = "-"
-
-	test := Resolve(statementMap, "root").Num
-	if test != 0 {
-		panic(fmt.Errorf("Did not resolve properly: %d", test))
+	// both sides of root are linear in humn, so solve left == right exactly
+	root := statementMap["root"]
+	left, err := mathy.Linearize(buildExpr(statementMap, root.Operand1, "humn"))
+	if err != nil {
+		panic(err)
+	}
+	right, err := mathy.Linearize(buildExpr(statementMap, root.Operand2, "humn"))
+	if err != nil {
+		panic(err)
 	}
 
-	return human
+	human, err := left.SolveEqual(right)
+	if err != nil {
+		panic(err)
+	}
+	ans, ok := human.Int()
+	if !ok {
+		panic(fmt.Errorf("No integer solution: %v", human))
+	}
+	return ans
 }
 
 func parseInput(input string) (ans []*Statement) {
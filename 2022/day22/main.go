@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/mheidinger/advent-of-code-go/cast"
+	"github.com/mheidinger/advent-of-code-go/data-structures/grid"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -51,7 +52,7 @@ type Position struct {
 	Direction int
 }
 
-func execStep(board [][]rune, step string, pos Position) Position {
+func execStep(board *grid.Grid[rune], step string, pos Position) Position {
 	switch step {
 	case "R":
 		pos.Direction = (pos.Direction + 1) % 4
@@ -68,10 +69,10 @@ func execStep(board [][]rune, step string, pos Position) Position {
 	return pos
 }
 
-func walkSteps(board [][]rune, numSteps int, pos Position) Position {
+func walkSteps(board *grid.Grid[rune], numSteps int, pos Position) Position {
 	for it := 0; it < numSteps; it++ {
 		newPos := getNewPos(board, pos)
-		if board[newPos.Y][newPos.X] == '#' {
+		if board.Get(newPos.Y, newPos.X) == '#' {
 			break
 		}
 		pos = newPos
@@ -79,7 +80,7 @@ func walkSteps(board [][]rune, numSteps int, pos Position) Position {
 	return pos
 }
 
-func getNewPos(board [][]rune, pos Position) Position {
+func getNewPos(board *grid.Grid[rune], pos Position) Position {
 	newPos := pos
 	switch newPos.Direction {
 	case DirRight:
@@ -91,9 +92,7 @@ func getNewPos(board [][]rune, pos Position) Position {
 	case DirUp:
 		newPos.Y--
 	}
-	if newPos.Y < 0 || newPos.Y >= len(board) ||
-		newPos.X < 0 || newPos.X >= len(board[newPos.Y]) ||
-		board[newPos.Y][newPos.X] == ' ' {
+	if !board.In(newPos.Y, newPos.X) || board.Get(newPos.Y, newPos.X) == ' ' {
 		// wrap around
 		newPos = getWrapAroundPosCube(board, pos)
 	}
@@ -109,7 +108,7 @@ func translate(min1, anchor2, val int, invert bool) int {
 	return anchor2 - diff
 }
 
-func getWrapAroundPosCube(board [][]rune, pos Position) Position {
+func getWrapAroundPosCube(board *grid.Grid[rune], pos Position) Position {
 	if pos.Y == 0 && pos.X < 100 {
 		// Edge 4a
 		pos.Direction = DirRight
@@ -189,12 +188,10 @@ func getWrapAroundPosCube(board [][]rune, pos Position) Position {
 	return pos
 }
 
-func getWrapAroundPos(board [][]rune, pos Position) Position {
+func getWrapAroundPos(board *grid.Grid[rune], pos Position) Position {
 	newPos := pos
 	for {
-		if pos.Y < 0 || pos.Y >= len(board) ||
-			pos.X < 0 || pos.X >= len(board[pos.Y]) ||
-			board[pos.Y][pos.X] == ' ' {
+		if !board.In(pos.Y, pos.X) || board.Get(pos.Y, pos.X) == ' ' {
 			break
 		}
 		newPos = pos
@@ -217,7 +214,7 @@ func part1(input string) int {
 	board, path := parseInput(input)
 
 	pos := Position{
-		X:         strings.Index(string(board[0]), "."),
+		X:         strings.Index(string(board.Row(0)), "."),
 		Y:         0,
 		Direction: DirRight,
 	}
@@ -243,7 +240,7 @@ func part2(input string) int {
 	board, path := parseInput(input)
 
 	pos := Position{
-		X:         strings.Index(string(board[0]), "."),
+		X:         strings.Index(string(board.Row(0)), "."),
 		Y:         0,
 		Direction: DirRight,
 	}
@@ -272,12 +269,20 @@ func part2(input string) int {
 	return ((pos.Y + 1) * 1000) + ((pos.X + 1) * 4) + pos.Direction
 }
 
-func parseInput(input string) (ans [][]rune, path string) {
+func parseInput(input string) (ans *grid.Grid[rune], path string) {
 	lines := strings.Split(input, "\n")
 	boardStr := lines[:len(lines)-2]
-	board := make([][]rune, 0, len(boardStr))
+
+	// the board lines end with the last tile, pad them with empty space to get
+	// a rectangular grid
+	width := 0
 	for _, str := range boardStr {
-		board = append(board, []rune(str))
+		if len(str) > width {
+			width = len(str)
+		}
+	}
+	for it, str := range boardStr {
+		boardStr[it] = str + strings.Repeat(" ", width-len(str))
 	}
-	return board, lines[len(lines)-1]
+	return grid.MustParseRunes(strings.Join(boardStr, "\n")), lines[len(lines)-1]
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/mheidinger/advent-of-code-go/data-structures/grid"
 	"github.com/mheidinger/advent-of-code-go/util"
 )
 
@@ -45,24 +46,25 @@ const (
 )
 
 type Elf struct {
-	x     int
-	y     int
-	nextX int
-	nextY int
+	x      int
+	y      int
+	nextX  int
+	nextY  int
+	moving bool
 }
 
-func (elf *Elf) SetNextPos(board [][]*Elf, startDirection int) bool {
-	elfNW := board[elf.y-1][elf.x-1]
-	elfN := board[elf.y-1][elf.x]
-	elfNE := board[elf.y-1][elf.x+1]
-	elfE := board[elf.y][elf.x+1]
-	elfSE := board[elf.y+1][elf.x+1]
-	elfS := board[elf.y+1][elf.x]
-	elfSW := board[elf.y+1][elf.x-1]
-	elfW := board[elf.y][elf.x-1]
-
-	if elfNW == nil && elfN == nil && elfNE == nil && elfE == nil &&
-		elfSE == nil && elfS == nil && elfSW == nil && elfW == nil {
+func (elf *Elf) SetNextPos(board *grid.SparseGrid[*Elf], startDirection int) bool {
+	elfNW := board.Has(elf.y-1, elf.x-1)
+	elfN := board.Has(elf.y-1, elf.x)
+	elfNE := board.Has(elf.y-1, elf.x+1)
+	elfE := board.Has(elf.y, elf.x+1)
+	elfSE := board.Has(elf.y+1, elf.x+1)
+	elfS := board.Has(elf.y+1, elf.x)
+	elfSW := board.Has(elf.y+1, elf.x-1)
+	elfW := board.Has(elf.y, elf.x-1)
+
+	if !elfNW && !elfN && !elfNE && !elfE &&
+		!elfSE && !elfS && !elfSW && !elfW {
 		return false
 	}
 
@@ -70,35 +72,23 @@ func (elf *Elf) SetNextPos(board [][]*Elf, startDirection int) bool {
 	for it := 0; it < 4; it++ {
 		switch direction {
 		case DirNorth:
-			if elfNW == nil &&
-				elfN == nil &&
-				elfNE == nil {
-				elf.nextX = elf.x
-				elf.nextY = elf.y - 1
+			if !elfNW && !elfN && !elfNE {
+				elf.setNext(elf.x, elf.y-1)
 				return true
 			}
 		case DirSouth:
-			if elfSW == nil &&
-				elfS == nil &&
-				elfSE == nil {
-				elf.nextX = elf.x
-				elf.nextY = elf.y + 1
+			if !elfSW && !elfS && !elfSE {
+				elf.setNext(elf.x, elf.y+1)
 				return true
 			}
 		case DirWest:
-			if elfNW == nil &&
-				elfW == nil &&
-				elfSW == nil {
-				elf.nextX = elf.x - 1
-				elf.nextY = elf.y
+			if !elfNW && !elfW && !elfSW {
+				elf.setNext(elf.x-1, elf.y)
 				return true
 			}
 		case DirEast:
-			if elfNE == nil &&
-				elfE == nil &&
-				elfSE == nil {
-				elf.nextX = elf.x + 1
-				elf.nextY = elf.y
+			if !elfNE && !elfE && !elfSE {
+				elf.setNext(elf.x+1, elf.y)
 				return true
 			}
 		}
@@ -107,20 +97,25 @@ func (elf *Elf) SetNextPos(board [][]*Elf, startDirection int) bool {
 	return false
 }
 
-func (elf *Elf) Move(board [][]*Elf) {
-	if elf.nextX == 0 {
+func (elf *Elf) setNext(x, y int) {
+	elf.nextX = x
+	elf.nextY = y
+	elf.moving = true
+}
+
+func (elf *Elf) Move(board *grid.SparseGrid[*Elf]) {
+	if !elf.moving {
 		return
 	}
 
-	board[elf.y][elf.x] = nil
-	board[elf.nextY][elf.nextX] = elf
+	board.Delete(elf.y, elf.x)
+	board.Set(elf.nextY, elf.nextX, elf)
 	elf.x = elf.nextX
 	elf.y = elf.nextY
-	elf.nextX = 0
-	elf.nextY = 0
+	elf.moving = false
 }
 
-func Simulate(board [][]*Elf, elves []*Elf, startDirection int) bool {
+func Simulate(board *grid.SparseGrid[*Elf], elves []*Elf, startDirection int) bool {
 	elfMoved := false
 	for _, elf := range elves {
 		if elf.SetNextPos(board, startDirection) {
@@ -128,16 +123,15 @@ func Simulate(board [][]*Elf, elves []*Elf, startDirection int) bool {
 		}
 	}
 
-	for _, elf1 := range elves {
-		checkX := elf1.nextX
-		checkY := elf1.nextY
-		for _, elf2 := range elves {
-			if elf1 != elf2 && checkX == elf2.nextX && checkY == elf2.nextY {
-				elf1.nextX = 0
-				elf1.nextY = 0
-				elf2.nextX = 0
-				elf2.nextY = 0
-			}
+	proposals := map[[2]int]int{}
+	for _, elf := range elves {
+		if elf.moving {
+			proposals[[2]int{elf.nextY, elf.nextX}]++
+		}
+	}
+	for _, elf := range elves {
+		if elf.moving && proposals[[2]int{elf.nextY, elf.nextX}] > 1 {
+			elf.moving = false
 		}
 	}
 
@@ -148,41 +142,8 @@ func Simulate(board [][]*Elf, elves []*Elf, startDirection int) bool {
 	return elfMoved
 }
 
-func getFreeFields(board [][]*Elf) int {
-	minX, maxX, minY, maxY := 9999, 0, 9999, 0
-	for y, row := range board {
-		for x, elf := range row {
-			if elf == nil {
-				continue
-			}
-			if x < minX {
-				minX = x
-			}
-			if x > maxX {
-				maxX = x
-			}
-			if y < minY {
-				minY = y
-			}
-			if y > maxY {
-				maxY = y
-			}
-		}
-	}
-
-	numFree := 0
-	for y := minY; y <= maxY; y++ {
-		for x := minX; x <= maxX; x++ {
-			if board[y][x] == nil {
-				numFree++
-				// fmt.Print(".")
-			} else {
-				// fmt.Print("#")
-			}
-		}
-		// fmt.Println()
-	}
-	return numFree
+func getFreeFields(board *grid.SparseGrid[*Elf]) int {
+	return board.Area() - board.Len()
 }
 
 func part1(input string) int {
@@ -213,21 +174,16 @@ func part2(input string) int {
 	return numRounds
 }
 
-func parseInput(input string) (ans [][]*Elf, elves []*Elf) {
-	ans = make([][]*Elf, 400)
-	for it := range ans {
-		ans[it] = make([]*Elf, 400)
-	}
-	elves = make([]*Elf, 0)
-
-	for y, line := range strings.Split(input, "\n") {
-		for x, char := range line {
-			if char == '#' {
-				elf := &Elf{x: 200 + x, y: 200 + y}
-				ans[elf.y][elf.x] = elf
-				elves = append(elves, elf)
-			}
-		}
-	}
-	return ans, elves
+func parseInput(input string) (board *grid.SparseGrid[*Elf], elves []*Elf) {
+	board = grid.ParseSparse(input, func(char rune) bool {
+		return char == '#'
+	}, func(char rune) *Elf {
+		return &Elf{}
+	})
+	board.Each(func(y, x int, elf *Elf) {
+		elf.x = x
+		elf.y = y
+		elves = append(elves, elf)
+	})
+	return board, elves
 }
@@ -1,6 +1,10 @@
 package algos
 
 // CombinationsInts returns all combinations of an input slice of a given length
+//
+// Deprecated in favor of the lazy, generic Combinations, which yields every
+// combination once in lexicographic order. CombinationsInts keeps its old
+// order, which repeats the combinations that do not contain nums[0]
 func CombinationsInts(nums []int, targetLength int) [][]int {
 	if targetLength > len(nums) {
 		panic("target length is greated than length of input slice")
@@ -0,0 +1,134 @@
+package algos
+
+// Cycle is the result of a cycle detection over a sequence of states
+// x0, x1 = step(x0), x2 = step(x1), ...
+// from index Start on the states repeat every Length steps
+type Cycle struct {
+	Start  int
+	Length int
+	// Metrics holds metric(x_i) for every simulated state, i.e. at least
+	// indices 0 through Start+Length if a cycle was found
+	Metrics []int
+}
+
+// Found returns true if an actual cycle was detected
+func (c Cycle) Found() bool {
+	return c.Length > 0
+}
+
+// StateIndex maps any step n onto the index of the equal state within the
+// first pass through the cycle, i.e. a value < Start+Length
+func (c Cycle) StateIndex(n int) int {
+	if n < c.Start+c.Length || !c.Found() {
+		return n
+	}
+	return c.Start + (n-c.Start)%c.Length
+}
+
+// Extrapolate returns the metric at step n, assuming the metric grows by the
+// same amount every pass through the cycle (e.g. the height of a tower)
+// Panics if the cycle was detected without a metric, or if n was not
+// simulated and no cycle was found
+func (c Cycle) Extrapolate(n int) int {
+	if len(c.Metrics) == 0 {
+		panic("no metrics to extrapolate, the cycle was detected with a nil metric")
+	}
+	if n < len(c.Metrics) {
+		return c.Metrics[n]
+	}
+	if !c.Found() {
+		panic("no cycle found to extrapolate the metric")
+	}
+	cycles := (n - c.Start) / c.Length
+	growth := c.Metrics[c.Start+c.Length] - c.Metrics[c.Start]
+	return c.Metrics[c.StateIndex(n)] + cycles*growth
+}
+
+// FindCycle detects a cycle by remembering the key of every state in a map,
+// it simulates at most maxSteps steps (pass a negative value for no limit)
+//
+// step may mutate and return the same state (e.g. a pointer to a big
+// simulation), because every state is only visited once. metric can be nil
+// if the result is not extrapolated
+func FindCycle[S any, K comparable](initial S, step func(S) S, key func(S) K, metric func(S) int, maxSteps int) Cycle {
+	seen := map[K]int{}
+	state := initial
+	var cycle Cycle
+	for i := 0; maxSteps < 0 || i <= maxSteps; i++ {
+		if metric != nil {
+			cycle.Metrics = append(cycle.Metrics, metric(state))
+		}
+		k := key(state)
+		if first, ok := seen[k]; ok {
+			cycle.Start = first
+			cycle.Length = i - first
+			return cycle
+		}
+		seen[k] = i
+		if i != maxSteps {
+			state = step(state)
+		}
+	}
+	return cycle
+}
+
+// FindCycleBrent detects a cycle with Brent's algorithm, which only needs
+// constant memory for the keys, but walks the sequence multiple times
+// It simulates at most maxSteps steps per walk (negative for no limit)
+//
+// step MUST NOT mutate its input, as the algorithm restarts from initial.
+// metric can be nil if the result is not extrapolated
+func FindCycleBrent[S any, K comparable](initial S, step func(S) S, key func(S) K, metric func(S) int, maxSteps int) Cycle {
+	// find the cycle length by moving the tortoise to the hare on every power
+	// of two until the hare catches up with it
+	power, length := 1, 1
+	tortoise, hare := initial, step(initial)
+	tortoiseKey, hareKey := key(tortoise), key(hare)
+	for steps := 1; tortoiseKey != hareKey; steps++ {
+		if maxSteps >= 0 && steps >= maxSteps {
+			return Cycle{Metrics: metrics(initial, step, metric, maxSteps)}
+		}
+		if power == length {
+			tortoise, tortoiseKey = hare, hareKey
+			power *= 2
+			length = 0
+		}
+		hare = step(hare)
+		hareKey = key(hare)
+		length++
+	}
+
+	// find the start by moving both pointers, length steps apart, in lockstep
+	tortoise, hare = initial, initial
+	for i := 0; i < length; i++ {
+		hare = step(hare)
+	}
+	start := 0
+	for key(tortoise) != key(hare) {
+		tortoise = step(tortoise)
+		hare = step(hare)
+		start++
+	}
+
+	return Cycle{
+		Start:   start,
+		Length:  length,
+		Metrics: metrics(initial, step, metric, start+length),
+	}
+}
+
+// metrics replays the sequence and collects the metric for steps 0 through n
+func metrics[S any](initial S, step func(S) S, metric func(S) int, n int) []int {
+	if metric == nil {
+		return nil
+	}
+	values := make([]int, 0, n+1)
+	state := initial
+	for i := 0; i <= n; i++ {
+		values = append(values, metric(state))
+		if i != n {
+			state = step(state)
+		}
+	}
+	return values
+}
@@ -0,0 +1,94 @@
+package algos_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/algos"
+)
+
+// tower grows by pos every step, pos runs 0, 1, 2, 3, 4, 5, 6, 3, 4, ...
+type tower struct {
+	pos    int
+	height int
+}
+
+func stepTower(t tower) tower {
+	next := t.pos + 1
+	if next > 6 {
+		next = 3
+	}
+	return tower{next, t.height + t.pos}
+}
+
+func towerPos(t tower) int    { return t.pos }
+func towerHeight(t tower) int { return t.height }
+
+func bruteForceHeight(n int) int {
+	t := tower{}
+	for i := 0; i < n; i++ {
+		t = stepTower(t)
+	}
+	return t.height
+}
+
+func TestFindCycle(t *testing.T) {
+	finders := map[string]func() algos.Cycle{
+		"map": func() algos.Cycle {
+			return algos.FindCycle(tower{}, stepTower, towerPos, towerHeight, -1)
+		},
+		"brent": func() algos.Cycle {
+			return algos.FindCycleBrent(tower{}, stepTower, towerPos, towerHeight, -1)
+		},
+	}
+	for name, find := range finders {
+		t.Run(name, func(t *testing.T) {
+			cycle := find()
+			if cycle.Start != 3 || cycle.Length != 4 {
+				t.Fatalf("cycle = start %d, length %d, want start 3, length 4", cycle.Start, cycle.Length)
+			}
+			for _, n := range []int{0, 2, 3, 7, 8, 100, 12345} {
+				if got, want := cycle.Extrapolate(n), bruteForceHeight(n); got != want {
+					t.Errorf("Extrapolate(%d) = %d, want %d", n, got, want)
+				}
+			}
+			if got := cycle.StateIndex(11); got != 3 {
+				t.Errorf("StateIndex(11) = %d, want 3", got)
+			}
+		})
+	}
+}
+
+func TestFindCycleMaxSteps(t *testing.T) {
+	increment := func(x int) int { return x + 1 }
+	identity := func(x int) int { return x }
+
+	for name, cycle := range map[string]algos.Cycle{
+		"map":   algos.FindCycle(0, increment, identity, identity, 10),
+		"brent": algos.FindCycleBrent(0, increment, identity, identity, 10),
+	} {
+		if cycle.Found() {
+			t.Errorf("%s: found a cycle in a strictly increasing sequence", name)
+		}
+		if got := cycle.Extrapolate(10); got != 10 {
+			t.Errorf("%s: Extrapolate(10) = %d, want the simulated 10", name, got)
+		}
+	}
+}
+
+func TestExtrapolateWithoutMetric(t *testing.T) {
+	step := func(x int) int { return (x + 1) % 3 }
+	identity := func(x int) int { return x }
+	cycle := algos.FindCycle(0, step, identity, nil, -1)
+	if !cycle.Found() {
+		t.Fatalf("FindCycle() did not find the cycle")
+	}
+
+	defer func() {
+		if err := recover(); err == nil || !strings.Contains(fmt.Sprint(err), "nil metric") {
+			t.Errorf("Extrapolate() panic = %v, want a message about the nil metric", err)
+		}
+	}()
+	cycle.Extrapolate(5)
+}
@@ -0,0 +1,156 @@
+package algos
+
+// The iterators below call fn for every result and stop as soon as fn returns
+// false, they return false if they were stopped early
+// To avoid allocations the slice passed to fn is reused between calls, copy it
+// (e.g. with append([]T{}, s...)) if it needs to be kept
+
+// Combinations calls fn for every k-combination of items in lexicographic
+// order of the indices, i.e. [a b c] with k=2 yields [a b], [a c], [b c]
+func Combinations[T any](items []T, k int, fn func(combo []T) bool) bool {
+	n := len(items)
+	if k < 0 || k > n {
+		return true
+	}
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+	combo := make([]T, k)
+	for {
+		for i, idx := range indices {
+			combo[i] = items[idx]
+		}
+		if !fn(combo) {
+			return false
+		}
+
+		// advance the rightmost index that still has room to the right
+		i := k - 1
+		for i >= 0 && indices[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// CombinationsWithReplacement calls fn for every multiset of k items, i.e.
+// [a b] with k=2 yields [a a], [a b], [b b]
+func CombinationsWithReplacement[T any](items []T, k int, fn func(combo []T) bool) bool {
+	n := len(items)
+	if k < 0 || (n == 0 && k > 0) {
+		return true
+	}
+	indices := make([]int, k)
+	combo := make([]T, k)
+	for {
+		for i, idx := range indices {
+			combo[i] = items[idx]
+		}
+		if !fn(combo) {
+			return false
+		}
+
+		i := k - 1
+		for i >= 0 && indices[i] == n-1 {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[i]
+		}
+	}
+}
+
+// Permutations calls fn for every permutation of items using Heap's algorithm,
+// which only needs a single swap between two consecutive permutations
+// items itself is not modified
+func Permutations[T any](items []T, fn func(perm []T) bool) bool {
+	perm := append([]T{}, items...)
+	if !fn(perm) {
+		return false
+	}
+
+	// counters replace the recursion stack of the recursive Heap's algorithm
+	counters := make([]int, len(perm))
+	for i := 1; i < len(perm); {
+		if counters[i] < i {
+			if i%2 == 0 {
+				perm[0], perm[i] = perm[i], perm[0]
+			} else {
+				perm[counters[i]], perm[i] = perm[i], perm[counters[i]]
+			}
+			if !fn(perm) {
+				return false
+			}
+			counters[i]++
+			i = 1
+		} else {
+			counters[i] = 0
+			i++
+		}
+	}
+	return true
+}
+
+// Product calls fn for every element of the cartesian product of sets, the
+// last set changes fastest, i.e. [[a b] [1 2]] yields [a 1], [a 2], [b 1], [b 2]
+func Product[T any](sets [][]T, fn func(tuple []T) bool) bool {
+	for _, set := range sets {
+		if len(set) == 0 {
+			return true
+		}
+	}
+	indices := make([]int, len(sets))
+	tuple := make([]T, len(sets))
+	for {
+		for i, idx := range indices {
+			tuple[i] = sets[i][idx]
+		}
+		if !fn(tuple) {
+			return false
+		}
+
+		i := len(sets) - 1
+		for ; i >= 0; i-- {
+			indices[i]++
+			if indices[i] < len(sets[i]) {
+				break
+			}
+			indices[i] = 0
+		}
+		if i < 0 {
+			return true
+		}
+	}
+}
+
+// ProductRepeat calls fn for every element of the cartesian product of items
+// with itself repeat times, i.e. all tuples of length repeat
+func ProductRepeat[T any](items []T, repeat int, fn func(tuple []T) bool) bool {
+	sets := make([][]T, repeat)
+	for i := range sets {
+		sets[i] = items
+	}
+	return Product(sets, fn)
+}
+
+// PowerSet calls fn for every subset of items, ordered by size and then
+// lexicographically like Combinations, starting with the empty set
+func PowerSet[T any](items []T, fn func(subset []T) bool) bool {
+	for k := 0; k <= len(items); k++ {
+		if !Combinations(items, k, fn) {
+			return false
+		}
+	}
+	return true
+}
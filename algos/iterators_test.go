@@ -0,0 +1,152 @@
+package algos_test
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/algos"
+)
+
+// join collects every result of an iterator as a string, e.g. "ab"
+func join(iterate func(fn func([]string) bool) bool) []string {
+	var results []string
+	iterate(func(s []string) bool {
+		results = append(results, strings.Join(s, ""))
+		return true
+	})
+	return results
+}
+
+func TestIterators(t *testing.T) {
+	abc := []string{"a", "b", "c"}
+	tests := []struct {
+		name    string
+		iterate func(fn func([]string) bool) bool
+		want    []string
+	}{
+		{
+			name: "combinations",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.Combinations(abc, 2, fn)
+			},
+			want: []string{"ab", "ac", "bc"},
+		},
+		{
+			name: "combinations k=0",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.Combinations(abc, 0, fn)
+			},
+			want: []string{""},
+		},
+		{
+			name: "combinations k>n",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.Combinations(abc, 4, fn)
+			},
+			want: nil,
+		},
+		{
+			name: "combinations with replacement",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.CombinationsWithReplacement(abc[:2], 2, fn)
+			},
+			want: []string{"aa", "ab", "bb"},
+		},
+		{
+			name: "product",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.Product([][]string{{"a", "b"}, {"1", "2"}}, fn)
+			},
+			want: []string{"a1", "a2", "b1", "b2"},
+		},
+		{
+			name: "product with empty set",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.Product([][]string{{"a", "b"}, {}}, fn)
+			},
+			want: nil,
+		},
+		{
+			name: "product repeat",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.ProductRepeat(abc[:2], 3, fn)
+			},
+			want: []string{"aaa", "aab", "aba", "abb", "baa", "bab", "bba", "bbb"},
+		},
+		{
+			name: "power set",
+			iterate: func(fn func([]string) bool) bool {
+				return algos.PowerSet(abc, fn)
+			},
+			want: []string{"", "a", "b", "c", "ab", "ac", "bc", "abc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := join(tt.iterate); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	perms := join(func(fn func([]string) bool) bool {
+		return algos.Permutations(items, fn)
+	})
+	if len(perms) != 24 {
+		t.Fatalf("got %d permutations, want 24", len(perms))
+	}
+	seen := map[string]bool{}
+	for _, perm := range perms {
+		seen[perm] = true
+	}
+	if len(seen) != 24 {
+		t.Errorf("got %d distinct permutations, want 24", len(seen))
+	}
+	if !reflect.DeepEqual(items, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Permutations() modified its input: %v", items)
+	}
+
+	got := algos.PermuteString("abc")
+	sort.Strings(got)
+	if want := []string{"abc", "acb", "bac", "bca", "cab", "cba"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PermuteString() = %v, want %v", got, want)
+	}
+}
+
+func TestIteratorsStopEarly(t *testing.T) {
+	calls := 0
+	completed := algos.Permutations([]int{1, 2, 3, 4, 5}, func(perm []int) bool {
+		calls++
+		return calls < 3
+	})
+	if completed || calls != 3 {
+		t.Errorf("Permutations() = %t after %d calls, want false after 3", completed, calls)
+	}
+
+	calls = 0
+	completed = algos.PowerSet([]int{1, 2, 3}, func(subset []int) bool {
+		calls++
+		return len(subset) < 2
+	})
+	if completed || calls != 5 {
+		t.Errorf("PowerSet() = %t after %d calls, want false after 5", completed, calls)
+	}
+}
+
+func TestDeprecatedWrappers(t *testing.T) {
+	// the deprecated wrapper keeps its old order including the repetitions
+	want := [][]int{{1, 2}, {1, 3}, {2, 3}, {2, 3}}
+	if got := algos.CombinationsInts([]int{1, 2, 3}, 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("CombinationsInts() = %v, want %v", got, want)
+	}
+
+	wantPerms := [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 2, 1}, {3, 1, 2}}
+	if got := algos.PermuteIntSlice([]int{1, 2, 3}); !reflect.DeepEqual(got, wantPerms) {
+		t.Errorf("PermuteIntSlice() = %v, want %v", got, wantPerms)
+	}
+}
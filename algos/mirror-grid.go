@@ -1,12 +1,10 @@
 package algos
 
+import "github.com/mheidinger/advent-of-code-go/data-structures/grid"
+
 // MirrorStringGrid returns the grid mirrored over the y-axis (i.e. left to right)
-func MirrorStringGrid(grid [][]string) (flipped [][]string) {
-	for i := range grid {
-		flipped = append(flipped, []string{})
-		for j := len(grid[i]) - 1; j >= 0; j-- {
-			flipped[i] = append(flipped[i], grid[i][j])
-		}
-	}
-	return flipped
+//
+// Deprecated in favor of (*grid.Grid).Mirror
+func MirrorStringGrid(g [][]string) [][]string {
+	return grid.FromSlices(g).Mirror().Slices()
 }
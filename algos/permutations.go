@@ -3,6 +3,10 @@ package algos
 import "strings"
 
 // PermuteIntSlice will make all permutations of the numbers input
+//
+// Deprecated in favor of the lazy, generic Permutations, which yields the
+// permutations in the order of Heap's algorithm instead of the swap order
+// used here
 func PermuteIntSlice(numbers []int) [][]int {
 	return recurseIntSlice(numbers, 0)
 }
@@ -25,6 +29,10 @@ func recurseIntSlice(numbers []int, startIndex int) [][]int {
 }
 
 // PermuteString generates all permutations for a given string
+//
+// Deprecated in favor of the lazy, generic Permutations, which yields the
+// permutations in the order of Heap's algorithm instead of the swap order
+// used here
 func PermuteString(str string) []string {
 	return recurseString(strings.Split(str, ""), 0)
 }
@@ -44,6 +52,10 @@ func recurseString(sli []string, index int) []string {
 }
 
 // PermuteStringSlice will make all permutations of a string slice
+//
+// Deprecated in favor of the lazy, generic Permutations, which yields the
+// permutations in the order of Heap's algorithm instead of the swap order
+// used here
 func PermuteStringSlice(in []string) [][]string {
 	return recurseStringsSlice(in, 0)
 }
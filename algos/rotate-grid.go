@@ -1,19 +1,13 @@
 package algos
 
-// RotateGrid returns the inputted grid, rotated counterclockwise
-// call it multiple times for 180, & 270 degree rotations
-func RotateStringGrid(grid [][]string) [][]string {
-	rotated := make([][]string, len(grid[0]))
-	for i := range rotated {
-		rotated[i] = make([]string, len(grid))
-	}
+import "github.com/mheidinger/advent-of-code-go/data-structures/grid"
 
-	for i := 0; i < len(grid); i++ {
-		for j := 0; j < len(grid[0]); j++ {
-			rotated[len(grid[0])-1-j][i] = grid[i][j]
-		}
-	}
-	return rotated
+// RotateStringGrid returns the inputted grid, rotated counterclockwise
+// call it multiple times for 180, & 270 degree rotations
+//
+// Deprecated in favor of (*grid.Grid).Rotate
+func RotateStringGrid(g [][]string) [][]string {
+	return grid.FromSlices(g).Rotate().Slices()
 }
 
 // RotateGridInts will transpose a 2D array of ints
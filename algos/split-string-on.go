@@ -1,21 +1,11 @@
 package algos
 
-import "strings"
+import "github.com/mheidinger/advent-of-code-go/parse"
 
 // SplitStringOn is like strings.Split but takes in a slice of strings that are
 // all used as dividers in the incoming string
+//
+// Deprecated in favor of parse.Split
 func SplitStringOn(in string, cutset []string) []string {
-	parts := strings.Split(in, cutset[0])
-	cutset = cutset[1:]
-	var done bool
-	for !done && len(cutset) > 0 {
-		divider := cutset[0]
-		cutset = cutset[1:]
-		var newParts []string
-		for _, oldPart := range parts {
-			newParts = append(newParts, strings.Split(oldPart, divider)...)
-		}
-		parts = newParts
-	}
-	return parts
+	return parse.Split(in, cutset...)
 }
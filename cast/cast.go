@@ -10,41 +10,80 @@ import (
 
 // ToInt will case a given arg into an int type.
 // Supported types are:
-//    - string
+//   - string
+//   - []byte
+//
+// Use Parse for an error instead of a panic
 func ToInt(arg interface{}) int {
-	var val int
-	switch arg.(type) {
+	switch arg := arg.(type) {
 	case string:
-		var err error
-		val, err = strconv.Atoi(arg.(string))
+		val, err := Parse[int](arg)
 		if err != nil {
 			panic("error converting string to int " + err.Error())
 		}
-	default:
-		panic(fmt.Sprintf("unhandled type for int casting %T", arg))
+		return val
+	case []byte:
+		return ToInt(string(arg))
 	}
-	return val
+	panic(fmt.Sprintf("unhandled type for int casting %T", arg))
 }
 
 // ToString will case a given arg into an int type.
 // Supported types are:
-//    - int
-//    - byte
-//    - rune
+//   - string
+//   - int, int64, uint64
+//   - float64
+//   - bool
+//   - byte
+//   - rune
+//   - fmt.Stringer
 func ToString(arg interface{}) string {
-	var str string
-	switch arg.(type) {
+	switch arg := arg.(type) {
+	case string:
+		return arg
 	case int:
-		str = strconv.Itoa(arg.(int))
+		return strconv.Itoa(arg)
+	case int64:
+		return strconv.FormatInt(arg, 10)
+	case uint64:
+		return strconv.FormatUint(arg, 10)
+	case float64:
+		return strconv.FormatFloat(arg, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(arg)
+	case byte:
+		return string(rune(arg))
+	case rune:
+		return string(arg)
+	case fmt.Stringer:
+		return arg.String()
+	}
+	panic(fmt.Sprintf("unhandled type for string casting %T", arg))
+}
+
+// ToBool will case a given arg into a bool type, see ParseBool for the
+// supported strings.
+// Supported types are:
+//   - string
+//   - byte
+//   - rune
+func ToBool(arg interface{}) bool {
+	var str string
+	switch arg := arg.(type) {
+	case string:
+		str = arg
 	case byte:
-		b := arg.(byte)
-		str = string(rune(b))
+		str = string(rune(arg))
 	case rune:
-		str = string(arg.(rune))
+		str = string(arg)
 	default:
-		panic(fmt.Sprintf("unhandled type for string casting %T", arg))
+		panic(fmt.Sprintf("unhandled type for bool casting %T", arg))
 	}
-	return str
+	val, err := ParseBool(str)
+	if err != nil {
+		panic(err)
+	}
+	return val
 }
 
 const (
@@ -55,22 +94,23 @@ const (
 )
 
 // ToASCIICode returns the ascii code of a given input
+// Supported types are:
+//   - string of length 1
+//   - byte
+//   - rune
 func ToASCIICode(arg interface{}) int {
-	var asciiVal int
-	switch arg.(type) {
+	switch arg := arg.(type) {
 	case string:
-		str := arg.(string)
-		if len(str) != 1 {
+		if len(arg) != 1 {
 			panic("can only convert ascii Code for string of length 1")
 		}
-		asciiVal = int(str[0])
+		return int(arg[0])
 	case byte:
-		asciiVal = int(arg.(byte))
+		return int(arg)
 	case rune:
-		asciiVal = int(arg.(rune))
+		return int(arg)
 	}
-
-	return asciiVal
+	panic(fmt.Sprintf("unhandled type for ascii code %T", arg))
 }
 
 // ASCIIIntToChar returns a one character string of the given int
@@ -27,6 +27,11 @@ func TestToString(t *testing.T) {
 		{"int", 512, "512"},
 		{"rune", rune(65), "A"},
 		{"rune", rune(97), "a"},
+		{"string", "abc", "abc"},
+		{"int64", int64(-5), "-5"},
+		{"uint64", uint64(7), "7"},
+		{"float64", 2.5, "2.5"},
+		{"bool", true, "true"},
 	}
 	for _, tt := range byteTests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,136 @@
+package cast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Number are the types Parse can convert to
+type Number interface {
+	int | int64 | uint64 | float64
+}
+
+// Integer are the types ParseBase can convert to
+type Integer interface {
+	int | int64 | uint64
+}
+
+// Parse converts a decimal string with optional sign and surrounding
+// whitespace into a T
+func Parse[T Number](s string) (T, error) {
+	var zero T
+	s = strings.TrimSpace(s)
+	switch any(zero).(type) {
+	case float64:
+		f, err := strconv.ParseFloat(s, 64)
+		return T(f), err
+	}
+	return parseInteger[T](s, 10)
+}
+
+// MustParse is Parse, but panics on errors
+func MustParse[T Number](s string) T {
+	val, err := Parse[T](s)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// ParseBase converts a string in the given base (2 to 36) into a T, with
+// optional sign and surrounding whitespace. A base of 0 detects it from the
+// prefix (0b, 0o, 0x)
+func ParseBase[T Integer](s string, base int) (T, error) {
+	return parseInteger[T](strings.TrimSpace(s), base)
+}
+
+// parseInteger is shared by Parse and ParseBase, T must not be float64
+func parseInteger[T Number](s string, base int) (T, error) {
+	var zero T
+	switch any(zero).(type) {
+	case uint64:
+		n, err := strconv.ParseUint(strings.TrimPrefix(s, "+"), base, 64)
+		return T(n), err
+	case int64:
+		n, err := strconv.ParseInt(s, base, 64)
+		return T(n), err
+	default:
+		n, err := strconv.ParseInt(s, base, strconv.IntSize)
+		return T(n), err
+	}
+}
+
+// MustParseBase is ParseBase, but panics on errors
+func MustParseBase[T Integer](s string, base int) T {
+	val, err := ParseBase[T](s, base)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// ParseHex converts a hexadecimal string with optional 0x prefix into a T
+func ParseHex[T Integer](s string) (T, error) {
+	return ParseBase[T](trimBasePrefix(s, "0x", "0X"), 16)
+}
+
+// ParseBinary converts a binary string with optional 0b prefix into a T
+func ParseBinary[T Integer](s string) (T, error) {
+	return ParseBase[T](trimBasePrefix(s, "0b", "0B"), 2)
+}
+
+// trimBasePrefix removes a base prefix while keeping the sign, i.e. "-0x1f"
+// becomes "-1f"
+func trimBasePrefix(s string, prefixes ...string) string {
+	s = strings.TrimSpace(s)
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	for _, prefix := range prefixes {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	return sign + s
+}
+
+// ParseBool converts true/false, yes/no, y/n, on/off and 1/0 in any case,
+// and the puzzle grid markers # (true) and . (false) into a bool
+func ParseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "t", "yes", "y", "on", "1", "#":
+		return true, nil
+	case "false", "f", "no", "n", "off", "0", ".":
+		return false, nil
+	}
+	return false, fmt.Errorf("parsing %q as bool: invalid syntax", s)
+}
+
+// ToInts splits line at sep (whitespace if sep is empty) and converts every
+// part into an int. Errors contain the position of the failing part
+func ToInts(line, sep string) ([]int, error) {
+	var parts []string
+	if sep == "" {
+		parts = strings.Fields(line)
+	} else {
+		parts = strings.Split(line, sep)
+	}
+	ints := make([]int, 0, len(parts))
+	for i, part := range parts {
+		n, err := Parse[int](part)
+		if err != nil {
+			return nil, fmt.Errorf("part %d: %w", i+1, err)
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
+
+// MustToInts is ToInts, but panics on errors
+func MustToInts(line, sep string) []int {
+	ints, err := ToInts(line, sep)
+	if err != nil {
+		panic(err)
+	}
+	return ints
+}
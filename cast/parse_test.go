@@ -0,0 +1,97 @@
+package cast_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/cast"
+)
+
+func TestParse(t *testing.T) {
+	if got, err := cast.Parse[int](" -42 "); err != nil || got != -42 {
+		t.Errorf("Parse[int](-42) = %v, %v", got, err)
+	}
+	if got, err := cast.Parse[int64]("+9000000000"); err != nil || got != 9000000000 {
+		t.Errorf("Parse[int64](+9000000000) = %v, %v", got, err)
+	}
+	if got, err := cast.Parse[uint64]("18446744073709551615"); err != nil || got != 18446744073709551615 {
+		t.Errorf("Parse[uint64](max) = %v, %v", got, err)
+	}
+	if got, err := cast.Parse[float64]("2.5"); err != nil || got != 2.5 {
+		t.Errorf("Parse[float64](2.5) = %v, %v", got, err)
+	}
+
+	for _, invalid := range []string{"", "abc", "1.5", "12x"} {
+		if _, err := cast.Parse[int](invalid); err == nil {
+			t.Errorf("Parse[int](%q) should fail", invalid)
+		}
+	}
+	if _, err := cast.Parse[uint64]("-1"); err == nil {
+		t.Errorf("Parse[uint64](-1) should fail")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParse should panic on invalid input")
+		}
+	}()
+	cast.MustParse[int]("nope")
+}
+
+func TestParseBase(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func() (int64, error)
+		want  int64
+	}{
+		{"hex", func() (int64, error) { return cast.ParseHex[int64]("ff") }, 255},
+		{"hex prefix", func() (int64, error) { return cast.ParseHex[int64]("0x1F") }, 31},
+		{"signed hex", func() (int64, error) { return cast.ParseHex[int64]("-0x10") }, -16},
+		{"binary", func() (int64, error) { return cast.ParseBinary[int64]("0b1011") }, 11},
+		{"signed binary", func() (int64, error) { return cast.ParseBinary[int64]("-101") }, -5},
+		{"auto detect", func() (int64, error) { return cast.ParseBase[int64]("0o17", 0) }, 15},
+		{"base 36", func() (int64, error) { return cast.ParseBase[int64]("z", 36) }, 35},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := tt.parse(); err != nil || got != tt.want {
+				t.Errorf("got %d, %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+	if _, err := cast.ParseBinary[int]("102"); err == nil {
+		t.Errorf("ParseBinary(102) should fail")
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	for _, s := range []string{"true", "Y", "yes", "1", "#", " on "} {
+		if got, err := cast.ParseBool(s); err != nil || !got {
+			t.Errorf("ParseBool(%q) = %t, %v, want true", s, got, err)
+		}
+	}
+	for _, s := range []string{"false", "n", "NO", "0", ".", "off"} {
+		if got, err := cast.ParseBool(s); err != nil || got {
+			t.Errorf("ParseBool(%q) = %t, %v, want false", s, got, err)
+		}
+	}
+	if _, err := cast.ParseBool("maybe"); err == nil {
+		t.Errorf("ParseBool(maybe) should fail")
+	}
+	if !cast.ToBool('#') || cast.ToBool(".") {
+		t.Errorf("ToBool() does not handle grid markers")
+	}
+}
+
+func TestToInts(t *testing.T) {
+	if got, err := cast.ToInts("79, 98, -3", ","); err != nil || !reflect.DeepEqual(got, []int{79, 98, -3}) {
+		t.Errorf("ToInts() = %v, %v", got, err)
+	}
+	if got := cast.MustToInts(" 1  2 3 ", ""); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("MustToInts() = %v", got)
+	}
+	if _, err := cast.ToInts("1,x,3", ","); err == nil || !strings.Contains(err.Error(), "part 2") {
+		t.Errorf("ToInts() error = %v, want it to name part 2", err)
+	}
+}
@@ -0,0 +1,148 @@
+// Package concurrency solves independent sub-problems (blueprints, rows,
+// start positions, ...) on a bounded number of goroutines
+//
+// All functions take the number of workers to use, values <= 0 use one
+// worker per CPU. The first error returned by fn cancels the context passed
+// to the other calls and is returned, as is the error of the parent context
+package concurrency
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// forEachIndex calls fn for every index in [0, n) on up to workers goroutines
+func forEachIndex(ctx context.Context, n, workers int, fn func(ctx context.Context, i int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	workCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next     int64 = -1
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for workCtx.Err() == nil {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if err := fn(workCtx, i); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// ParallelMapRange returns fn(i) for every i in [start, end), results[k] is
+// always fn(start+k) regardless of the order they were computed in
+func ParallelMapRange[R any](ctx context.Context, start, end, workers int, fn func(ctx context.Context, i int) (R, error)) ([]R, error) {
+	if end < start {
+		return nil, nil
+	}
+	results := make([]R, end-start)
+	err := forEachIndex(ctx, end-start, workers, func(ctx context.Context, k int) error {
+		res, err := fn(ctx, start+k)
+		results[k] = res
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ParallelMap returns fn(item) for every item in the same order as items
+func ParallelMap[T, R any](ctx context.Context, items []T, workers int, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	return ParallelMapRange(ctx, 0, len(items), workers, func(ctx context.Context, i int) (R, error) {
+		return fn(ctx, items[i])
+	})
+}
+
+// ParallelReduceRange maps every i in [start, end) with fn in parallel and
+// then folds the results with combine in index order, so combine does not
+// have to be commutative
+func ParallelReduceRange[R, A any](ctx context.Context, start, end, workers int, fn func(ctx context.Context, i int) (R, error), initial A, combine func(acc A, val R) A) (A, error) {
+	results, err := ParallelMapRange(ctx, start, end, workers, fn)
+	if err != nil {
+		return initial, err
+	}
+	acc := initial
+	for _, res := range results {
+		acc = combine(acc, res)
+	}
+	return acc, nil
+}
+
+// ParallelReduce maps every item with fn in parallel and then folds the
+// results with combine in the order of items
+func ParallelReduce[T, R, A any](ctx context.Context, items []T, workers int, fn func(ctx context.Context, item T) (R, error), initial A, combine func(acc A, val R) A) (A, error) {
+	return ParallelReduceRange(ctx, 0, len(items), workers, func(ctx context.Context, i int) (R, error) {
+		return fn(ctx, items[i])
+	}, initial, combine)
+}
+
+// FirstInRange calls fn for the indices in [start, end) until one of them
+// reports found, then cancels the remaining calls. The first reported result
+// wins, which is not necessarily the one of the smallest index
+// found is false if no call reported a result
+func FirstInRange[R any](ctx context.Context, start, end, workers int, fn func(ctx context.Context, i int) (res R, found bool, err error)) (res R, index int, found bool, err error) {
+	if end <= start {
+		return res, -1, false, nil
+	}
+	workCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	index = -1
+	err = forEachIndex(workCtx, end-start, workers, func(ctx context.Context, k int) error {
+		r, ok, err := fn(ctx, start+k)
+		if err != nil || !ok {
+			return err
+		}
+		once.Do(func() {
+			res, index, found = r, start+k, true
+			cancel()
+		})
+		return nil
+	})
+	if found {
+		return res, index, true, nil
+	}
+	// the parent context error takes precedence over our own cancellation
+	if ctx.Err() != nil {
+		return res, -1, false, ctx.Err()
+	}
+	return res, -1, false, err
+}
+
+// First calls fn for the items until one of them reports found, see FirstInRange
+func First[T, R any](ctx context.Context, items []T, workers int, fn func(ctx context.Context, item T) (res R, found bool, err error)) (res R, index int, found bool, err error) {
+	return FirstInRange(ctx, 0, len(items), workers, func(ctx context.Context, i int) (R, bool, error) {
+		return fn(ctx, items[i])
+	})
+}
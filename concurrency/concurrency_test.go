@@ -0,0 +1,111 @@
+package concurrency_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mheidinger/advent-of-code-go/concurrency"
+)
+
+func square(_ context.Context, n int) (int, error) {
+	return n * n, nil
+}
+
+func TestParallelMap(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	for _, workers := range []int{0, 1, 3, 100} {
+		got, err := concurrency.ParallelMap(context.Background(), items, workers, square)
+		want := []int{1, 4, 9, 16, 25, 36, 49, 64, 81, 100}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ParallelMap() with %d workers = %v, %v, want %v", workers, got, err, want)
+		}
+	}
+
+	got, err := concurrency.ParallelMapRange(context.Background(), 3, 6, 2, square)
+	if err != nil || !reflect.DeepEqual(got, []int{9, 16, 25}) {
+		t.Errorf("ParallelMapRange() = %v, %v, want [9 16 25]", got, err)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	// combine is not commutative, so this only works with deterministic ordering
+	got, err := concurrency.ParallelReduce(context.Background(), []string{"a", "b", "c", "d"}, 4,
+		func(_ context.Context, s string) (string, error) {
+			return s + s, nil
+		}, "", func(acc, val string) string {
+			return acc + val
+		})
+	if err != nil || got != "aabbccdd" {
+		t.Errorf("ParallelReduce() = %q, %v, want aabbccdd", got, err)
+	}
+
+	sum, err := concurrency.ParallelReduceRange(context.Background(), 1, 101, 0, square, 0, func(acc, val int) int {
+		return acc + val
+	})
+	if err != nil || sum != 338350 {
+		t.Errorf("ParallelReduceRange() = %d, %v, want 338350", sum, err)
+	}
+}
+
+func TestErrorsAndCancellation(t *testing.T) {
+	errBoom := errors.New("boom")
+	var calls int64
+	_, err := concurrency.ParallelMapRange(context.Background(), 0, 1000, 2, func(ctx context.Context, i int) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		if i == 10 {
+			return 0, errBoom
+		}
+		return i, nil
+	})
+	if !errors.Is(err, errBoom) {
+		t.Errorf("ParallelMapRange() error = %v, want %v", err, errBoom)
+	}
+	if calls == 1000 {
+		t.Errorf("error did not stop the remaining calls")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := concurrency.ParallelMap(ctx, []int{1, 2, 3}, 2, square); !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelMap() with cancelled context error = %v, want context.Canceled", err)
+	}
+}
+
+func TestFirst(t *testing.T) {
+	res, index, found, err := concurrency.FirstInRange(context.Background(), 0, 1_000_000, 4,
+		func(ctx context.Context, i int) (int, bool, error) {
+			return i * 2, i == 777, nil
+		})
+	if err != nil || !found || index != 777 || res != 1554 {
+		t.Errorf("FirstInRange() = %d, %d, %t, %v, want 1554, 777, true", res, index, found, err)
+	}
+
+	_, index, found, err = concurrency.First(context.Background(), []string{"a", "b"}, 2,
+		func(ctx context.Context, s string) (string, bool, error) {
+			return s, false, nil
+		})
+	if err != nil || found || index != -1 {
+		t.Errorf("First() = %d, %t, %v, want -1, false, nil", index, found, err)
+	}
+
+	// the slow calls see the cancellation once the result is found
+	start := time.Now()
+	_, _, found, _ = concurrency.FirstInRange(context.Background(), 0, 8, 8,
+		func(ctx context.Context, i int) (int, bool, error) {
+			if i == 0 {
+				return i, true, nil
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(10 * time.Second):
+			}
+			return i, false, nil
+		})
+	if !found || time.Since(start) > 5*time.Second {
+		t.Errorf("FirstInRange() did not cancel the remaining calls")
+	}
+}
@@ -0,0 +1,271 @@
+// Package dag works with directed acyclic graphs, e.g. steps that depend on
+// other steps or monkeys waiting for the numbers of other monkeys
+package dag
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/queue"
+)
+
+// ErrCycle is wrapped by every CycleError
+var ErrCycle = errors.New("graph has a cycle")
+
+// CycleError is returned if a graph is not acyclic, Cycle lists the nodes of
+// one cycle in edge direction, the last node has an edge to the first one
+type CycleError[K comparable] struct {
+	Cycle []K
+}
+
+func (e *CycleError[K]) Error() string {
+	parts := make([]string, 0, len(e.Cycle)+1)
+	for _, node := range e.Cycle {
+		parts = append(parts, fmt.Sprint(node))
+	}
+	parts = append(parts, fmt.Sprint(e.Cycle[0]))
+	return fmt.Sprintf("%v: %s", ErrCycle, strings.Join(parts, " -> "))
+}
+
+func (e *CycleError[K]) Unwrap() error {
+	return ErrCycle
+}
+
+// Graph is a directed graph, an edge from a to b means a has to come before b
+// Nodes keep the order they were added in, which breaks ties in TopoSort
+type Graph[K comparable] struct {
+	nodes        []K
+	index        map[K]int
+	successors   [][]int
+	predecessors [][]int
+}
+
+// New returns an empty graph
+func New[K comparable]() *Graph[K] {
+	return &Graph[K]{index: map[K]int{}}
+}
+
+// AddNode adds node if it is not part of the graph yet
+func (g *Graph[K]) AddNode(node K) {
+	g.id(node)
+}
+
+// AddEdge adds an edge from before to after, adding both nodes if needed
+func (g *Graph[K]) AddEdge(before, after K) {
+	from, to := g.id(before), g.id(after)
+	g.successors[from] = append(g.successors[from], to)
+	g.predecessors[to] = append(g.predecessors[to], from)
+}
+
+// Len returns the number of nodes
+func (g *Graph[K]) Len() int {
+	return len(g.nodes)
+}
+
+// Has reports whether node is part of the graph
+func (g *Graph[K]) Has(node K) bool {
+	_, ok := g.index[node]
+	return ok
+}
+
+// Nodes returns all nodes in the order they were added
+func (g *Graph[K]) Nodes() []K {
+	return append([]K{}, g.nodes...)
+}
+
+// Successors returns the nodes with an edge from node, in the order the
+// edges were added
+func (g *Graph[K]) Successors(node K) []K {
+	return g.keys(g.successors[g.mustID(node)])
+}
+
+// Predecessors returns the nodes with an edge to node, in the order the
+// edges were added
+func (g *Graph[K]) Predecessors(node K) []K {
+	return g.keys(g.predecessors[g.mustID(node)])
+}
+
+// TopoSort returns the nodes in an order where every node comes after all of
+// its predecessors (Kahn's algorithm). Of the nodes ready at the same time
+// the one added first comes first. Returns a *CycleError if there is none
+func (g *Graph[K]) TopoSort() ([]K, error) {
+	inDegree := g.inDegrees()
+	ready := queue.New[int]()
+	for id, degree := range inDegree {
+		if degree == 0 {
+			ready.Push(id)
+		}
+	}
+
+	order := make([]K, 0, len(g.nodes))
+	for ready.Len() > 0 {
+		id, _ := ready.Pop()
+		order = append(order, g.nodes[id])
+		for _, next := range g.successors[id] {
+			if inDegree[next]--; inDegree[next] == 0 {
+				ready.Push(next)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return nil, &CycleError[K]{Cycle: g.cycle(inDegree)}
+	}
+	return order, nil
+}
+
+// TopoSortBy is TopoSort, but of the nodes ready at the same time the
+// smallest according to less comes first, e.g. the alphabetically first
+// step. This returns the lexicographically smallest order
+func (g *Graph[K]) TopoSortBy(less func(a, b K) bool) ([]K, error) {
+	inDegree := g.inDegrees()
+	ready := g.newReady(less)
+	for id, degree := range inDegree {
+		if degree == 0 {
+			heap.Push(ready, id)
+		}
+	}
+
+	order := make([]K, 0, len(g.nodes))
+	for ready.Len() > 0 {
+		id := heap.Pop(ready).(int)
+		order = append(order, g.nodes[id])
+		for _, next := range g.successors[id] {
+			if inDegree[next]--; inDegree[next] == 0 {
+				heap.Push(ready, next)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return nil, &CycleError[K]{Cycle: g.cycle(inDegree)}
+	}
+	return order, nil
+}
+
+// FindCycle returns the nodes of a cycle in edge direction, nil if the graph
+// is acyclic
+func (g *Graph[K]) FindCycle() []K {
+	_, err := g.TopoSort()
+	var cycleErr *CycleError[K]
+	if errors.As(err, &cycleErr) {
+		return cycleErr.Cycle
+	}
+	return nil
+}
+
+// Ancestors returns all nodes with a path to node, in the order they were
+// added, excluding node itself unless it is part of a cycle
+func (g *Graph[K]) Ancestors(node K) []K {
+	seen := make([]bool, len(g.nodes))
+	stack := []int{g.mustID(node)}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, prev := range g.predecessors[id] {
+			if !seen[prev] {
+				seen[prev] = true
+				stack = append(stack, prev)
+			}
+		}
+	}
+	var ancestors []K
+	for id, ok := range seen {
+		if ok {
+			ancestors = append(ancestors, g.nodes[id])
+		}
+	}
+	return ancestors
+}
+
+// cycle finds a cycle among the nodes Kahn's algorithm could not remove
+// Every one of them still has a remaining predecessor, so walking backwards
+// along them has to run into a node visited before
+func (g *Graph[K]) cycle(inDegree []int) []K {
+	start := -1
+	for id, degree := range inDegree {
+		if degree > 0 {
+			start = id
+			break
+		}
+	}
+	visitedAt := map[int]int{}
+	var path []int
+	for id := start; ; {
+		if at, ok := visitedAt[id]; ok {
+			path = path[at:]
+			break
+		}
+		visitedAt[id] = len(path)
+		path = append(path, id)
+		for _, prev := range g.predecessors[id] {
+			if inDegree[prev] > 0 {
+				id = prev
+				break
+			}
+		}
+	}
+	// the path was walked against the edges
+	cycle := make([]K, len(path))
+	for i, id := range path {
+		cycle[len(path)-1-i] = g.nodes[id]
+	}
+	return cycle
+}
+
+func (g *Graph[K]) inDegrees() []int {
+	inDegree := make([]int, len(g.nodes))
+	for id, prev := range g.predecessors {
+		inDegree[id] = len(prev)
+	}
+	return inDegree
+}
+
+func (g *Graph[K]) id(node K) int {
+	if id, ok := g.index[node]; ok {
+		return id
+	}
+	id := len(g.nodes)
+	g.index[node] = id
+	g.nodes = append(g.nodes, node)
+	g.successors = append(g.successors, nil)
+	g.predecessors = append(g.predecessors, nil)
+	return id
+}
+
+func (g *Graph[K]) mustID(node K) int {
+	id, ok := g.index[node]
+	if !ok {
+		panic(fmt.Sprintf("unknown node %v", node))
+	}
+	return id
+}
+
+func (g *Graph[K]) keys(ids []int) []K {
+	keys := make([]K, len(ids))
+	for i, id := range ids {
+		keys[i] = g.nodes[id]
+	}
+	return keys
+}
+
+// readyHeap orders node ids by less of their nodes, for container/heap
+type readyHeap[K comparable] struct {
+	ids   []int
+	nodes []K
+	less  func(a, b K) bool
+}
+
+func (g *Graph[K]) newReady(less func(a, b K) bool) *readyHeap[K] {
+	return &readyHeap[K]{nodes: g.nodes, less: less}
+}
+
+func (h *readyHeap[K]) Len() int           { return len(h.ids) }
+func (h *readyHeap[K]) Less(i, j int) bool { return h.less(h.nodes[h.ids[i]], h.nodes[h.ids[j]]) }
+func (h *readyHeap[K]) Swap(i, j int)      { h.ids[i], h.ids[j] = h.ids[j], h.ids[i] }
+func (h *readyHeap[K]) Push(x any)         { h.ids = append(h.ids, x.(int)) }
+func (h *readyHeap[K]) Pop() any {
+	id := h.ids[len(h.ids)-1]
+	h.ids = h.ids[:len(h.ids)-1]
+	return id
+}
@@ -0,0 +1,131 @@
+package dag_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/dag"
+)
+
+// steps builds the graph of the sleigh assembly instructions example
+func steps() *dag.Graph[string] {
+	g := dag.New[string]()
+	for _, edge := range [][2]string{{"C", "A"}, {"C", "F"}, {"A", "B"}, {"A", "D"}, {"B", "E"}, {"D", "E"}, {"F", "E"}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+	return g
+}
+
+func TestTopoSort(t *testing.T) {
+	g := steps()
+	order, err := g.TopoSort()
+	if err != nil || !reflect.DeepEqual(order, []string{"C", "A", "F", "B", "D", "E"}) {
+		t.Errorf("TopoSort() = %v, %v", order, err)
+	}
+	order, err = g.TopoSortBy(func(a, b string) bool { return a < b })
+	if err != nil || !reflect.DeepEqual(order, []string{"C", "A", "B", "D", "F", "E"}) {
+		t.Errorf("TopoSortBy() = %v, %v", order, err)
+	}
+	if cycle := g.FindCycle(); cycle != nil {
+		t.Errorf("FindCycle() = %v, want none", cycle)
+	}
+	if got := g.Ancestors("D"); !reflect.DeepEqual(got, []string{"C", "A"}) {
+		t.Errorf("Ancestors() = %v", got)
+	}
+	if got := g.Successors("A"); !reflect.DeepEqual(got, []string{"B", "D"}) {
+		t.Errorf("Successors() = %v", got)
+	}
+}
+
+func TestCycle(t *testing.T) {
+	g := steps()
+	g.AddEdge("E", "X")
+	g.AddEdge("X", "A")
+	_, err := g.TopoSort()
+	var cycleErr *dag.CycleError[string]
+	if !errors.Is(err, dag.ErrCycle) || !errors.As(err, &cycleErr) {
+		t.Fatalf("TopoSort() error = %v, want a CycleError", err)
+	}
+
+	// the cycle may start anywhere, but has to follow the edges
+	cycle := cycleErr.Cycle
+	for i, node := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		found := false
+		for _, succ := range g.Successors(node) {
+			found = found || succ == next
+		}
+		if !found {
+			t.Errorf("cycle %v has no edge %s -> %s", cycle, node, next)
+		}
+	}
+	if len(cycle) != 4 {
+		t.Errorf("cycle = %v, want A, B or D, E and X", cycle)
+	}
+	if _, err := g.TopoSortBy(func(a, b string) bool { return a < b }); !errors.Is(err, dag.ErrCycle) {
+		t.Errorf("TopoSortBy() error = %v, want ErrCycle", err)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	g := steps()
+	duration := func(step string) int { return int(step[0]-'A') + 1 }
+	total, tasks, err := g.Schedule(2, duration, func(a, b string) bool { return a < b })
+	if err != nil || total != 15 {
+		t.Fatalf("Schedule() = %d, %v, want 15", total, err)
+	}
+	want := []dag.Task[string]{
+		{Node: "C", Worker: 0, Start: 0, End: 3},
+		{Node: "A", Worker: 0, Start: 3, End: 4},
+		{Node: "F", Worker: 1, Start: 3, End: 9},
+		{Node: "B", Worker: 0, Start: 4, End: 6},
+		{Node: "D", Worker: 0, Start: 6, End: 10},
+		{Node: "E", Worker: 0, Start: 10, End: 15},
+	}
+	if !reflect.DeepEqual(tasks, want) {
+		t.Errorf("Schedule() tasks = %+v, want %+v", tasks, want)
+	}
+
+	if total, _, _ := g.Schedule(1, duration, nil); total != 21 {
+		t.Errorf("Schedule() with one worker = %d, want 21", total)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	// root: a + b, a: 3, b: c * c, c: 2, unused: c - a
+	g := dag.New[string]()
+	for _, edge := range [][2]string{{"a", "root"}, {"b", "root"}, {"c", "b"}, {"c", "unused"}, {"a", "unused"}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+	evaluated := map[string]int{}
+	eval := func(node string, value func(string) int) int {
+		evaluated[node]++
+		switch node {
+		case "root":
+			return value("a") + value("b")
+		case "a":
+			return 3
+		case "b":
+			return value("c") * value("c")
+		case "c":
+			return 2
+		case "unused":
+			return value("c") - value("a")
+		}
+		panic(node)
+	}
+
+	root, err := dag.EvaluateNode(g, "root", eval)
+	if err != nil || root != 7 {
+		t.Errorf("EvaluateNode() = %d, %v, want 7", root, err)
+	}
+	if !reflect.DeepEqual(evaluated, map[string]int{"root": 1, "a": 1, "b": 1, "c": 1}) {
+		t.Errorf("EvaluateNode() evaluated %v, want every ancestor once", evaluated)
+	}
+
+	values, err := dag.Evaluate(g, eval)
+	if err != nil || values["unused"] != -1 || values["root"] != 7 {
+		t.Errorf("Evaluate() = %v, %v", values, err)
+	}
+}
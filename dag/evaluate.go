@@ -0,0 +1,49 @@
+package dag
+
+import "fmt"
+
+// Evaluate computes the value of every node exactly once, in topological
+// order. eval gets the node and value, which returns the already computed
+// value of a predecessor. Returns a *CycleError if the graph has a cycle
+func Evaluate[K comparable, V any](g *Graph[K], eval func(node K, value func(K) V) V) (map[K]V, error) {
+	order, err := g.TopoSort()
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[K]V, len(order))
+	value := func(node K) V {
+		val, ok := values[node]
+		if !ok {
+			panic(fmt.Sprintf("value of %v is not evaluated yet, is the edge to it missing?", node))
+		}
+		return val
+	}
+	for _, node := range order {
+		values[node] = eval(node, value)
+	}
+	return values, nil
+}
+
+// EvaluateNode is Evaluate limited to node and its ancestors, so nodes that
+// do not contribute to node are never evaluated. It returns the value of node
+func EvaluateNode[K comparable, V any](g *Graph[K], node K, eval func(node K, value func(K) V) V) (V, error) {
+	sub := New[K]()
+	for _, ancestor := range g.Ancestors(node) {
+		sub.AddNode(ancestor)
+	}
+	sub.AddNode(node)
+	for _, n := range sub.nodes {
+		for _, next := range g.successors[g.index[n]] {
+			if sub.Has(g.nodes[next]) {
+				sub.AddEdge(n, g.nodes[next])
+			}
+		}
+	}
+
+	values, err := Evaluate(sub, eval)
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	return values[node], nil
+}
@@ -0,0 +1,88 @@
+package dag
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// Task is a node worked on in a Schedule
+type Task[K comparable] struct {
+	Node   K
+	Worker int
+	Start  int
+	End    int
+}
+
+// Schedule simulates the given number of workers working through the graph,
+// a node can be started once all of its predecessors are finished and takes
+// duration(node) time. Free workers take the ready nodes in the order of
+// less, or the order they were added if less is nil, and the free worker
+// with the lowest number takes the next one
+// Returns the time when all nodes are finished and the tasks ordered by
+// their start, or a *CycleError if the graph has a cycle
+func (g *Graph[K]) Schedule(workers int, duration func(node K) int, less func(a, b K) bool) (int, []Task[K], error) {
+	if workers <= 0 {
+		panic("schedule needs at least one worker")
+	}
+	if _, err := g.TopoSort(); err != nil {
+		return 0, nil, err
+	}
+	if less == nil {
+		less = func(a, b K) bool { return g.index[a] < g.index[b] }
+	}
+
+	inDegree := g.inDegrees()
+	ready := g.newReady(less)
+	for id, degree := range inDegree {
+		if degree == 0 {
+			heap.Push(ready, id)
+		}
+	}
+	free := make([]int, workers)
+	for it := range free {
+		free[it] = it
+	}
+
+	var tasks []Task[K]
+	var running []Task[K]
+	time, finished := 0, 0
+	for finished < len(g.nodes) {
+		for len(free) > 0 && ready.Len() > 0 {
+			node := g.nodes[heap.Pop(ready).(int)]
+			took := duration(node)
+			if took < 0 {
+				panic(fmt.Sprintf("negative duration %d for %v", took, node))
+			}
+			task := Task[K]{Node: node, Worker: free[0], Start: time, End: time + took}
+			free = free[1:]
+			tasks = append(tasks, task)
+			running = append(running, task)
+		}
+
+		// jump to the next time a task finishes and finish all ending then
+		time = running[0].End
+		for _, task := range running[1:] {
+			if task.End < time {
+				time = task.End
+			}
+		}
+		stillRunning := running[:0]
+		for _, task := range running {
+			if task.End > time {
+				stillRunning = append(stillRunning, task)
+				continue
+			}
+			finished++
+			free = append(free, task.Worker)
+			for _, next := range g.successors[g.index[task.Node]] {
+				if inDegree[next]--; inDegree[next] == 0 {
+					heap.Push(ready, next)
+				}
+			}
+		}
+		running = stillRunning
+		sort.Ints(free)
+	}
+	return time, tasks, nil
+}
@@ -0,0 +1,207 @@
+// Package bitset provides sets of small non negative ints stored as bits,
+// e.g. for opened valves or the rows of a chamber
+package bitset
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Bitset is a set of ints backed by a slice of words. A fixed bitset holds
+// the ints 0 to Len()-1 and panics outside of them, a growable one grows on
+// Set and treats everything beyond its length as unset
+type Bitset struct {
+	words    []uint64
+	size     int
+	growable bool
+}
+
+// New returns an empty fixed bitset for the ints 0 to size-1
+func New(size int) *Bitset {
+	if size < 0 {
+		panic("negative bitset size")
+	}
+	return &Bitset{words: make([]uint64, wordsFor(size)), size: size}
+}
+
+// NewGrowable returns an empty bitset that grows as needed
+func NewGrowable() *Bitset {
+	return &Bitset{growable: true}
+}
+
+// Len returns the number of ints the bitset can currently hold
+func (b *Bitset) Len() int {
+	return b.size
+}
+
+// Set adds i to the set
+func (b *Bitset) Set(i int) {
+	if i >= b.size && b.growable && i >= 0 {
+		b.grow(i + 1)
+	}
+	b.check(i)
+	b.words[i/64] |= 1 << (i % 64)
+}
+
+// Clear removes i from the set
+func (b *Bitset) Clear(i int) {
+	if b.growable && i >= b.size {
+		return
+	}
+	b.check(i)
+	b.words[i/64] &^= 1 << (i % 64)
+}
+
+// Test reports whether i is in the set
+func (b *Bitset) Test(i int) bool {
+	if b.growable && i >= b.size {
+		return false
+	}
+	b.check(i)
+	return b.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Count returns the number of ints in the set
+func (b *Bitset) Count() int {
+	return count(b.words)
+}
+
+// IsEmpty reports whether no int is in the set
+func (b *Bitset) IsEmpty() bool {
+	return b.Count() == 0
+}
+
+// Union returns a new bitset with the ints in b or other
+func (b *Bitset) Union(other *Bitset) *Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Intersection returns a new bitset with the ints in both b and other
+func (b *Bitset) Intersection(other *Bitset) *Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// Difference returns a new bitset with the ints in b but not in other
+func (b *Bitset) Difference(other *Bitset) *Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// Disjoint reports whether b and other have no int in common
+func (b *Bitset) Disjoint(other *Bitset) bool {
+	for i := 0; i < len(b.words) && i < len(other.words); i++ {
+		if b.words[i]&other.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether b and other contain the same ints, independent of
+// their lengths
+func (b *Bitset) Equal(other *Bitset) bool {
+	return b.Difference(other).IsEmpty() && other.Difference(b).IsEmpty()
+}
+
+// Each calls fn for every int in the set in ascending order, it stops early
+// if fn returns false
+func (b *Bitset) Each(fn func(i int) bool) {
+	each(b.words, fn)
+}
+
+// Indices returns all ints in the set in ascending order
+func (b *Bitset) Indices() []int {
+	var indices []int
+	b.Each(func(i int) bool {
+		indices = append(indices, i)
+		return true
+	})
+	return indices
+}
+
+// Clone returns an independent copy of b
+func (b *Bitset) Clone() *Bitset {
+	return &Bitset{words: append([]uint64{}, b.words...), size: b.size, growable: b.growable}
+}
+
+// String lists the ints in the set, e.g. {1 4 9}
+func (b *Bitset) String() string {
+	return format(b.words)
+}
+
+// combine applies op word by word, the result is as long as the longer one
+// and growable if either of them is
+func (b *Bitset) combine(other *Bitset, op func(x, y uint64) uint64) *Bitset {
+	size := b.size
+	if other.size > size {
+		size = other.size
+	}
+	result := New(size)
+	result.growable = b.growable || other.growable
+	for i := range result.words {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		result.words[i] = op(x, y)
+	}
+	return result
+}
+
+// grow makes room for at least size ints, doubling the words to keep Set
+// amortized O(1)
+func (b *Bitset) grow(size int) {
+	if need := wordsFor(size); need > len(b.words) {
+		if need < 2*len(b.words) {
+			need = 2 * len(b.words)
+		}
+		words := make([]uint64, need)
+		copy(words, b.words)
+		b.words = words
+	}
+	b.size = size
+}
+
+func (b *Bitset) check(i int) {
+	if i < 0 || i >= b.size {
+		panic(fmt.Sprintf("bit %d out of range for bitset of length %d", i, b.size))
+	}
+}
+
+func wordsFor(size int) int {
+	return (size + 63) / 64
+}
+
+// count, each and format are shared with the small fixed variants
+
+func count(words []uint64) int {
+	n := 0
+	for _, word := range words {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+func each(words []uint64, fn func(i int) bool) {
+	for w, word := range words {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			if !fn(w*64 + bit) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+func format(words []uint64) string {
+	var parts []string
+	each(words, func(i int) bool {
+		parts = append(parts, fmt.Sprint(i))
+		return true
+	})
+	return "{" + strings.Join(parts, " ") + "}"
+}
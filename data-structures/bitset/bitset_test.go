@@ -0,0 +1,100 @@
+package bitset_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/bitset"
+)
+
+func TestBitset(t *testing.T) {
+	b := bitset.New(130)
+	for _, i := range []int{0, 5, 64, 129} {
+		b.Set(i)
+	}
+	b.Clear(5)
+	if !b.Test(64) || b.Test(5) || b.Count() != 3 {
+		t.Errorf("Test()/Count() do not match the set bits: %v", b)
+	}
+	if got := b.Indices(); !reflect.DeepEqual(got, []int{0, 64, 129}) {
+		t.Errorf("Indices() = %v", got)
+	}
+	if got := b.String(); got != "{0 64 129}" {
+		t.Errorf("String() = %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Set() beyond a fixed bitset should panic")
+		}
+	}()
+	b.Set(130)
+}
+
+func TestBitsetGrowable(t *testing.T) {
+	a := bitset.NewGrowable()
+	if a.Test(1000) {
+		t.Errorf("Test() beyond the length should be false")
+	}
+	a.Set(3)
+	a.Set(200)
+	if a.Len() != 201 || !a.Test(200) {
+		t.Errorf("Set() did not grow the bitset, Len() = %d", a.Len())
+	}
+	a.Clear(5000)
+
+	b := bitset.New(10)
+	b.Set(3)
+	b.Set(7)
+	if got := a.Union(b).Indices(); !reflect.DeepEqual(got, []int{3, 7, 200}) {
+		t.Errorf("Union() = %v", got)
+	}
+	if got := a.Intersection(b).Indices(); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("Intersection() = %v", got)
+	}
+	if got := a.Difference(b).Indices(); !reflect.DeepEqual(got, []int{200}) {
+		t.Errorf("Difference() = %v", got)
+	}
+	if a.Disjoint(b) || !a.Difference(b).Disjoint(b) {
+		t.Errorf("Disjoint() does not match the common bits")
+	}
+	clone := b.Clone()
+	clone.Clear(7)
+	if !b.Test(7) || b.Equal(clone) || !clone.Equal(a.Intersection(b)) {
+		t.Errorf("Clone()/Equal() do not work independently")
+	}
+}
+
+func TestSmallBits(t *testing.T) {
+	var a bitset.Bits64
+	a = a.Set(1).Set(63).Set(5).Clear(5)
+	b := bitset.Bits64(0).Set(1).Set(2)
+	if !a.Test(63) || a.Test(5) || a.Count() != 2 {
+		t.Errorf("Bits64 = %v", a)
+	}
+	if a.Union(b).Count() != 3 || a.Intersection(b) != bitset.Bits64(0).Set(1) || a.Disjoint(b) {
+		t.Errorf("Bits64 set operations do not match")
+	}
+	if !a.Difference(b).Disjoint(b) {
+		t.Errorf("Bits64 Difference() = %v", a.Difference(b))
+	}
+
+	seen := map[bitset.Bits256]int{}
+	c := bitset.Bits256{}.Set(0).Set(200)
+	seen[c]++
+	seen[bitset.Bits256{}.Set(200).Set(0)]++
+	if seen[c] != 2 || c.String() != "{0 200}" || c.Count() != 2 {
+		t.Errorf("Bits256 is not usable as map key: %v", seen)
+	}
+	var indices []int
+	bitset.Bits128{}.Set(127).Set(64).Set(3).Each(func(i int) bool {
+		indices = append(indices, i)
+		return i < 64
+	})
+	if !reflect.DeepEqual(indices, []int{3, 64}) {
+		t.Errorf("Bits128 Each() = %v, want it to stop after 64", indices)
+	}
+	if !(bitset.Bits128{}).IsEmpty() || (bitset.Bits128{}).Set(100).Clear(100) != (bitset.Bits128{}) {
+		t.Errorf("Bits128 Clear() did not remove the bit")
+	}
+}
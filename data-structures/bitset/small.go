@@ -0,0 +1,223 @@
+package bitset
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Bits64 is a comparable set of the ints 0 to 63, usable as a map key
+// All methods return new values and leave the receiver unchanged
+type Bits64 uint64
+
+// Set returns b with i added
+func (b Bits64) Set(i int) Bits64 {
+	mustFit(i, 64)
+	return b | 1<<i
+}
+
+// Clear returns b with i removed
+func (b Bits64) Clear(i int) Bits64 {
+	mustFit(i, 64)
+	return b &^ (1 << i)
+}
+
+// Test reports whether i is in the set
+func (b Bits64) Test(i int) bool {
+	mustFit(i, 64)
+	return b&(1<<i) != 0
+}
+
+// Count returns the number of ints in the set
+func (b Bits64) Count() int {
+	return bits.OnesCount64(uint64(b))
+}
+
+// IsEmpty reports whether no int is in the set
+func (b Bits64) IsEmpty() bool {
+	return b == 0
+}
+
+// Union returns the ints in b or other
+func (b Bits64) Union(other Bits64) Bits64 {
+	return b | other
+}
+
+// Intersection returns the ints in both b and other
+func (b Bits64) Intersection(other Bits64) Bits64 {
+	return b & other
+}
+
+// Difference returns the ints in b but not in other
+func (b Bits64) Difference(other Bits64) Bits64 {
+	return b &^ other
+}
+
+// Disjoint reports whether b and other have no int in common
+func (b Bits64) Disjoint(other Bits64) bool {
+	return b&other == 0
+}
+
+// Each calls fn for every int in the set in ascending order, it stops early
+// if fn returns false
+func (b Bits64) Each(fn func(i int) bool) {
+	each([]uint64{uint64(b)}, fn)
+}
+
+// String lists the ints in the set, e.g. {1 4 9}
+func (b Bits64) String() string {
+	return format([]uint64{uint64(b)})
+}
+
+// Bits128 is a comparable set of the ints 0 to 127, usable as a map key
+// All methods return new values and leave the receiver unchanged
+type Bits128 [2]uint64
+
+// Set returns b with i added
+func (b Bits128) Set(i int) Bits128 {
+	mustFit(i, 128)
+	b[i/64] |= 1 << (i % 64)
+	return b
+}
+
+// Clear returns b with i removed
+func (b Bits128) Clear(i int) Bits128 {
+	mustFit(i, 128)
+	b[i/64] &^= 1 << (i % 64)
+	return b
+}
+
+// Test reports whether i is in the set
+func (b Bits128) Test(i int) bool {
+	mustFit(i, 128)
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// Count returns the number of ints in the set
+func (b Bits128) Count() int {
+	return count(b[:])
+}
+
+// IsEmpty reports whether no int is in the set
+func (b Bits128) IsEmpty() bool {
+	return b == Bits128{}
+}
+
+// Union returns the ints in b or other
+func (b Bits128) Union(other Bits128) Bits128 {
+	for i := range b {
+		b[i] |= other[i]
+	}
+	return b
+}
+
+// Intersection returns the ints in both b and other
+func (b Bits128) Intersection(other Bits128) Bits128 {
+	for i := range b {
+		b[i] &= other[i]
+	}
+	return b
+}
+
+// Difference returns the ints in b but not in other
+func (b Bits128) Difference(other Bits128) Bits128 {
+	for i := range b {
+		b[i] &^= other[i]
+	}
+	return b
+}
+
+// Disjoint reports whether b and other have no int in common
+func (b Bits128) Disjoint(other Bits128) bool {
+	return b.Intersection(other).IsEmpty()
+}
+
+// Each calls fn for every int in the set in ascending order, it stops early
+// if fn returns false
+func (b Bits128) Each(fn func(i int) bool) {
+	each(b[:], fn)
+}
+
+// String lists the ints in the set, e.g. {1 4 9}
+func (b Bits128) String() string {
+	return format(b[:])
+}
+
+// Bits256 is a comparable set of the ints 0 to 255, usable as a map key
+// All methods return new values and leave the receiver unchanged
+type Bits256 [4]uint64
+
+// Set returns b with i added
+func (b Bits256) Set(i int) Bits256 {
+	mustFit(i, 256)
+	b[i/64] |= 1 << (i % 64)
+	return b
+}
+
+// Clear returns b with i removed
+func (b Bits256) Clear(i int) Bits256 {
+	mustFit(i, 256)
+	b[i/64] &^= 1 << (i % 64)
+	return b
+}
+
+// Test reports whether i is in the set
+func (b Bits256) Test(i int) bool {
+	mustFit(i, 256)
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// Count returns the number of ints in the set
+func (b Bits256) Count() int {
+	return count(b[:])
+}
+
+// IsEmpty reports whether no int is in the set
+func (b Bits256) IsEmpty() bool {
+	return b == Bits256{}
+}
+
+// Union returns the ints in b or other
+func (b Bits256) Union(other Bits256) Bits256 {
+	for i := range b {
+		b[i] |= other[i]
+	}
+	return b
+}
+
+// Intersection returns the ints in both b and other
+func (b Bits256) Intersection(other Bits256) Bits256 {
+	for i := range b {
+		b[i] &= other[i]
+	}
+	return b
+}
+
+// Difference returns the ints in b but not in other
+func (b Bits256) Difference(other Bits256) Bits256 {
+	for i := range b {
+		b[i] &^= other[i]
+	}
+	return b
+}
+
+// Disjoint reports whether b and other have no int in common
+func (b Bits256) Disjoint(other Bits256) bool {
+	return b.Intersection(other).IsEmpty()
+}
+
+// Each calls fn for every int in the set in ascending order, it stops early
+// if fn returns false
+func (b Bits256) Each(fn func(i int) bool) {
+	each(b[:], fn)
+}
+
+// String lists the ints in the set, e.g. {1 4 9}
+func (b Bits256) String() string {
+	return format(b[:])
+}
+
+func mustFit(i, size int) {
+	if i < 0 || i >= size {
+		panic(fmt.Sprintf("bit %d out of range for %d bits", i, size))
+	}
+}
@@ -0,0 +1,242 @@
+// Package grid contains a generic, bounded 2D grid for the many puzzles that
+// parse their input into a [][]T and then walk around in it
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Grid is a rectangular 2D grid indexed by [row][col]
+type Grid[T comparable] struct {
+	cells [][]T
+}
+
+// New returns a grid of the given size where every cell holds the zero value
+func New[T comparable](height, width int) *Grid[T] {
+	cells := make([][]T, height)
+	for r := range cells {
+		cells[r] = make([]T, width)
+	}
+	return &Grid[T]{cells: cells}
+}
+
+// FromSlices wraps a [][]T into a Grid, the rows are copied so the grid does
+// not share memory with the input. All rows must have the same length
+func FromSlices[T comparable](rows [][]T) *Grid[T] {
+	g := &Grid[T]{cells: make([][]T, len(rows))}
+	for r, row := range rows {
+		if len(row) != len(rows[0]) {
+			panic(fmt.Sprintf("row %d has length %d, want %d", r, len(row), len(rows[0])))
+		}
+		g.cells[r] = append([]T{}, row...)
+	}
+	return g
+}
+
+// ErrRagged is returned if the lines of a parsed grid differ in length
+var ErrRagged = errors.New("lines have different lengths")
+
+// Parse builds a grid from a multiline input string, every rune is converted
+// into a cell value with the convert func. Trailing newlines are ignored, all
+// other lines must have the same number of runes
+func Parse[T comparable](input string, convert func(r rune) T) (*Grid[T], error) {
+	var rows [][]T
+	for it, line := range strings.Split(strings.TrimRight(input, "\n"), "\n") {
+		row := make([]T, 0, len(line))
+		for _, char := range line {
+			row = append(row, convert(char))
+		}
+		if it > 0 && len(row) != len(rows[0]) {
+			return nil, fmt.Errorf("line %d has %d runes, want %d: %w", it+1, len(row), len(rows[0]), ErrRagged)
+		}
+		rows = append(rows, row)
+	}
+	return &Grid[T]{cells: rows}, nil
+}
+
+// MustParse is Parse, but panics on errors
+func MustParse[T comparable](input string, convert func(r rune) T) *Grid[T] {
+	g, err := Parse(input, convert)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// ParseRunes builds a grid of the raw runes of a multiline input string, see
+// Parse
+func ParseRunes(input string) (*Grid[rune], error) {
+	return Parse(input, func(r rune) rune { return r })
+}
+
+// MustParseRunes is ParseRunes, but panics on errors
+func MustParseRunes(input string) *Grid[rune] {
+	return MustParse(input, func(r rune) rune { return r })
+}
+
+// Height returns the number of rows
+func (g *Grid[T]) Height() int {
+	return len(g.cells)
+}
+
+// Width returns the number of columns
+func (g *Grid[T]) Width() int {
+	if len(g.cells) == 0 {
+		return 0
+	}
+	return len(g.cells[0])
+}
+
+// In returns true if the coordinate is within the bounds of the grid
+func (g *Grid[T]) In(row, col int) bool {
+	return row >= 0 && row < g.Height() && col >= 0 && col < g.Width()
+}
+
+// Get returns the value at a coordinate, panics if it is out of bounds
+func (g *Grid[T]) Get(row, col int) T {
+	return g.cells[row][col]
+}
+
+// Set overwrites the value at a coordinate, panics if it is out of bounds
+func (g *Grid[T]) Set(row, col int, val T) {
+	g.cells[row][col] = val
+}
+
+var (
+	offsets4 = [][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+	offsets8 = [][2]int{{-1, 0}, {-1, 1}, {0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}}
+)
+
+// Neighbors4 returns the coordinates of the up to 4 orthogonal neighbors that
+// are within the grid, starting at the top and going clockwise
+func (g *Grid[T]) Neighbors4(row, col int) [][2]int {
+	return g.neighbors(row, col, offsets4)
+}
+
+// Neighbors8 returns the coordinates of the up to 8 neighbors (including
+// diagonals) that are within the grid, starting at the top and going clockwise
+func (g *Grid[T]) Neighbors8(row, col int) [][2]int {
+	return g.neighbors(row, col, offsets8)
+}
+
+func (g *Grid[T]) neighbors(row, col int, offsets [][2]int) [][2]int {
+	coords := make([][2]int, 0, len(offsets))
+	for _, off := range offsets {
+		r, c := row+off[0], col+off[1]
+		if g.In(r, c) {
+			coords = append(coords, [2]int{r, c})
+		}
+	}
+	return coords
+}
+
+// Each calls fn for every cell, row by row
+func (g *Grid[T]) Each(fn func(row, col int, val T)) {
+	for r, cells := range g.cells {
+		for c, val := range cells {
+			fn(r, c, val)
+		}
+	}
+}
+
+// Row returns a copy of the given row
+func (g *Grid[T]) Row(row int) []T {
+	return append([]T{}, g.cells[row]...)
+}
+
+// Col returns a copy of the given column, top to bottom
+func (g *Grid[T]) Col(col int) []T {
+	column := make([]T, 0, g.Height())
+	for _, cells := range g.cells {
+		column = append(column, cells[col])
+	}
+	return column
+}
+
+// Find returns the coordinate of the first cell (row by row) holding val and
+// a boolean indicating if it was found at all
+func (g *Grid[T]) Find(val T) (row, col int, found bool) {
+	for r, cells := range g.cells {
+		for c, cell := range cells {
+			if cell == val {
+				return r, c, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// Slices returns a copy of the underlying cells as a [][]T
+func (g *Grid[T]) Slices() [][]T {
+	return FromSlices(g.cells).cells
+}
+
+// Transpose returns a new grid mirrored over the main diagonal, i.e. rows
+// become columns
+func (g *Grid[T]) Transpose() *Grid[T] {
+	transposed := New[T](g.Width(), g.Height())
+	g.Each(func(row, col int, val T) {
+		transposed.cells[col][row] = val
+	})
+	return transposed
+}
+
+// Rotate returns a new grid rotated counterclockwise by 90 degrees
+// call it multiple times for 180, & 270 degree rotations
+func (g *Grid[T]) Rotate() *Grid[T] {
+	rotated := New[T](g.Width(), g.Height())
+	g.Each(func(row, col int, val T) {
+		rotated.cells[g.Width()-1-col][row] = val
+	})
+	return rotated
+}
+
+// Mirror returns a new grid mirrored over the y-axis (i.e. left to right)
+func (g *Grid[T]) Mirror() *Grid[T] {
+	mirrored := New[T](g.Height(), g.Width())
+	g.Each(func(row, col int, val T) {
+		mirrored.cells[row][g.Width()-1-col] = val
+	})
+	return mirrored
+}
+
+// Format renders the grid with one line per row, every cell is turned into a
+// string by the format func
+func (g *Grid[T]) Format(format func(val T) string) string {
+	var sb strings.Builder
+	for r, cells := range g.cells {
+		if r > 0 {
+			sb.WriteString("\n")
+		}
+		for _, val := range cells {
+			sb.WriteString(format(val))
+		}
+	}
+	return sb.String()
+}
+
+// String renders the grid with one line per row. runes, bytes and strings are
+// printed as is, bools as # and ., anything else via fmt.Sprint
+func (g *Grid[T]) String() string {
+	return g.Format(formatCell[T])
+}
+
+func formatCell[T any](val T) string {
+	switch v := any(val).(type) {
+	case rune:
+		return string(v)
+	case byte:
+		return string(rune(v))
+	case string:
+		return v
+	case bool:
+		if v {
+			return "#"
+		}
+		return "."
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,121 @@
+package grid_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/grid"
+)
+
+var example = `abc
+def`
+
+func TestParseRunes(t *testing.T) {
+	g := grid.MustParseRunes(example)
+	if g.Height() != 2 || g.Width() != 3 {
+		t.Fatalf("ParseRunes() size = %dx%d, want 2x3", g.Height(), g.Width())
+	}
+	if got := g.Get(1, 2); got != 'f' {
+		t.Errorf("Get(1, 2) = %q, want 'f'", got)
+	}
+	g.Set(0, 0, 'X')
+	if got := g.String(); got != "Xbc\ndef" {
+		t.Errorf("String() = %q, want %q", got, "Xbc\ndef")
+	}
+	if got := g.Row(1); !reflect.DeepEqual(got, []rune("def")) {
+		t.Errorf("Row(1) = %q, want %q", got, "def")
+	}
+	if got := g.Col(1); !reflect.DeepEqual(got, []rune("be")) {
+		t.Errorf("Col(1) = %q, want %q", got, "be")
+	}
+}
+
+func TestParseRagged(t *testing.T) {
+	if _, err := grid.ParseRunes("abc\nde\nfgh"); !errors.Is(err, grid.ErrRagged) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("ParseRunes() error = %v, want ErrRagged in line 2", err)
+	}
+	if g, err := grid.ParseRunes(example + "\n"); err != nil || g.Height() != 2 {
+		t.Errorf("ParseRunes() with trailing newline = %v, %v", g, err)
+	}
+}
+
+func TestIn(t *testing.T) {
+	g := grid.New[int](2, 3)
+	tests := []struct {
+		row, col int
+		want     bool
+	}{
+		{0, 0, true},
+		{1, 2, true},
+		{2, 0, false},
+		{0, 3, false},
+		{-1, 0, false},
+		{0, -1, false},
+	}
+	for _, tt := range tests {
+		if got := g.In(tt.row, tt.col); got != tt.want {
+			t.Errorf("In(%d, %d) = %v, want %v", tt.row, tt.col, got, tt.want)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := grid.MustParseRunes(example)
+	if got, want := g.Neighbors4(0, 0), [][2]int{{0, 1}, {1, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors4(0, 0) = %v, want %v", got, want)
+	}
+	if got, want := g.Neighbors4(1, 1), [][2]int{{0, 1}, {1, 2}, {1, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors4(1, 1) = %v, want %v", got, want)
+	}
+	if got, want := g.Neighbors8(0, 1), [][2]int{{0, 2}, {1, 2}, {1, 1}, {1, 0}, {0, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors8(0, 1) = %v, want %v", got, want)
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := grid.MustParseRunes(example)
+	if row, col, found := g.Find('e'); !found || row != 1 || col != 1 {
+		t.Errorf("Find('e') = %d, %d, %v, want 1, 1, true", row, col, found)
+	}
+	if _, _, found := g.Find('z'); found {
+		t.Errorf("Find('z') found = true, want false")
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	g := grid.MustParseRunes(example)
+	tests := []struct {
+		name string
+		got  *grid.Grid[rune]
+		want string
+	}{
+		{"transpose", g.Transpose(), "ad\nbe\ncf"},
+		{"rotate", g.Rotate(), "cf\nbe\nad"},
+		{"rotate twice", g.Rotate().Rotate(), "fed\ncba"},
+		{"mirror", g.Mirror(), "cba\nfed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	// original grid must be unchanged
+	if got := g.String(); got != example {
+		t.Errorf("original grid changed to %q", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	g := grid.FromSlices([][]bool{{true, false}, {false, true}})
+	if got, want := g.String(), "#.\n.#"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	ints := grid.FromSlices([][]int{{1, 2}, {3, 4}})
+	if got, want := ints.String(), "12\n34"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
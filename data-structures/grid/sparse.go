@@ -0,0 +1,225 @@
+package grid
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/queue"
+	"github.com/mheidinger/advent-of-code-go/mathy"
+)
+
+// SparseGrid is an unbounded 2D grid backed by a map[[2]int]T, indexed by
+// [row, col]. The min/max bounds of all cells ever set are tracked so it can be
+// rendered or walked without scanning the map first
+type SparseGrid[T any] struct {
+	cells map[[2]int]T
+	// bounds are only valid if len(cells) > 0 and not dirty
+	minRow, maxRow, minCol, maxCol int
+	// dirty is set if a cell on the edge was deleted and the bounds might shrink
+	dirty bool
+}
+
+// NewSparse returns an empty sparse grid
+func NewSparse[T any]() *SparseGrid[T] {
+	return &SparseGrid[T]{cells: map[[2]int]T{}}
+}
+
+// SparseFromMap wraps a map[[2]int]T into a sparse grid, the map is copied
+func SparseFromMap[T any](m map[[2]int]T) *SparseGrid[T] {
+	s := NewSparse[T]()
+	for coord, val := range m {
+		s.Set(coord[0], coord[1], val)
+	}
+	return s
+}
+
+// ParseSparse builds a sparse grid from a multiline input string, only runes
+// for which keep returns true are stored, converted via convert
+func ParseSparse[T any](input string, keep func(r rune) bool, convert func(r rune) T) *SparseGrid[T] {
+	s := NewSparse[T]()
+	for row, line := range strings.Split(input, "\n") {
+		for col, char := range []rune(line) {
+			if keep(char) {
+				s.Set(row, col, convert(char))
+			}
+		}
+	}
+	return s
+}
+
+// Len returns the number of cells that are set
+func (s *SparseGrid[T]) Len() int {
+	return len(s.cells)
+}
+
+// Get returns the value at a coordinate and a boolean if that cell is set
+func (s *SparseGrid[T]) Get(row, col int) (T, bool) {
+	val, ok := s.cells[[2]int{row, col}]
+	return val, ok
+}
+
+// Has returns true if the cell at the coordinate is set
+func (s *SparseGrid[T]) Has(row, col int) bool {
+	_, ok := s.cells[[2]int{row, col}]
+	return ok
+}
+
+// Set stores a value at a coordinate and grows the bounds if needed
+func (s *SparseGrid[T]) Set(row, col int, val T) {
+	if len(s.cells) == 0 {
+		s.minRow, s.maxRow, s.minCol, s.maxCol = row, row, col, col
+		s.dirty = false
+	} else if !s.dirty {
+		s.minRow = mathy.MinInt(s.minRow, row)
+		s.maxRow = mathy.MaxInt(s.maxRow, row)
+		s.minCol = mathy.MinInt(s.minCol, col)
+		s.maxCol = mathy.MaxInt(s.maxCol, col)
+	}
+	s.cells[[2]int{row, col}] = val
+}
+
+// Delete removes the cell at a coordinate, the bounds are recalculated lazily
+func (s *SparseGrid[T]) Delete(row, col int) {
+	if _, ok := s.cells[[2]int{row, col}]; !ok {
+		return
+	}
+	delete(s.cells, [2]int{row, col})
+	if row == s.minRow || row == s.maxRow || col == s.minCol || col == s.maxCol {
+		s.dirty = true
+	}
+}
+
+// Bounds returns the smallest and largest row and col of all set cells, all
+// zero if the grid is empty
+func (s *SparseGrid[T]) Bounds() (minRow, minCol, maxRow, maxCol int) {
+	if len(s.cells) == 0 {
+		return 0, 0, 0, 0
+	}
+	if s.dirty {
+		first := true
+		for coord := range s.cells {
+			if first {
+				s.minRow, s.maxRow, s.minCol, s.maxCol = coord[0], coord[0], coord[1], coord[1]
+				first = false
+				continue
+			}
+			s.minRow = mathy.MinInt(s.minRow, coord[0])
+			s.maxRow = mathy.MaxInt(s.maxRow, coord[0])
+			s.minCol = mathy.MinInt(s.minCol, coord[1])
+			s.maxCol = mathy.MaxInt(s.maxCol, coord[1])
+		}
+		s.dirty = false
+	}
+	return s.minRow, s.minCol, s.maxRow, s.maxCol
+}
+
+// Area returns the size of the bounding rectangle of all set cells
+func (s *SparseGrid[T]) Area() int {
+	if len(s.cells) == 0 {
+		return 0
+	}
+	minRow, minCol, maxRow, maxCol := s.Bounds()
+	return (maxRow - minRow + 1) * (maxCol - minCol + 1)
+}
+
+// Neighbors4 returns the coordinates of the set orthogonal neighbors, starting
+// at the top and going clockwise
+func (s *SparseGrid[T]) Neighbors4(row, col int) [][2]int {
+	return s.neighbors(row, col, offsets4)
+}
+
+// Neighbors8 returns the coordinates of the set neighbors (including
+// diagonals), starting at the top and going clockwise
+func (s *SparseGrid[T]) Neighbors8(row, col int) [][2]int {
+	return s.neighbors(row, col, offsets8)
+}
+
+func (s *SparseGrid[T]) neighbors(row, col int, offsets [][2]int) [][2]int {
+	var coords [][2]int
+	for _, off := range offsets {
+		coord := [2]int{row + off[0], col + off[1]}
+		if _, ok := s.cells[coord]; ok {
+			coords = append(coords, coord)
+		}
+	}
+	return coords
+}
+
+// Each calls fn for every set cell, in no particular order
+func (s *SparseGrid[T]) Each(fn func(row, col int, val T)) {
+	for coord, val := range s.cells {
+		fn(coord[0], coord[1], val)
+	}
+}
+
+// Count returns the number of set cells that match the predicate
+func (s *SparseGrid[T]) Count(pred func(val T) bool) int {
+	var count int
+	for _, val := range s.cells {
+		if pred(val) {
+			count++
+		}
+	}
+	return count
+}
+
+// FloodFill returns all coordinates reachable from the start via orthogonal
+// steps for which passable returns true, set is false for unset cells.
+// The fill is limited to the bounds of the grid grown by one in every
+// direction, so that filling "around" the set cells terminates
+func (s *SparseGrid[T]) FloodFill(row, col int, passable func(row, col int, val T, set bool) bool) [][2]int {
+	minRow, minCol, maxRow, maxCol := s.Bounds()
+	minRow, minCol, maxRow, maxCol = minRow-1, minCol-1, maxRow+1, maxCol+1
+
+	start := [2]int{row, col}
+	seen := map[[2]int]bool{start: true}
+	frontier := queue.New(start)
+	var filled [][2]int
+	for frontier.Len() > 0 {
+		current, _ := frontier.Pop()
+		filled = append(filled, current)
+
+		for _, off := range offsets4 {
+			next := [2]int{current[0] + off[0], current[1] + off[1]}
+			if seen[next] || next[0] < minRow || next[0] > maxRow || next[1] < minCol || next[1] > maxCol {
+				continue
+			}
+			val, ok := s.cells[next]
+			if !passable(next[0], next[1], val, ok) {
+				continue
+			}
+			seen[next] = true
+			frontier.Push(next)
+		}
+	}
+	return filled
+}
+
+// Write renders all cells within the bounds to w, one line per row. Unset
+// cells are written as empty, set cells are formatted with format
+func (s *SparseGrid[T]) Write(w io.Writer, empty string, format func(val T) string) error {
+	if len(s.cells) == 0 {
+		return nil
+	}
+	minRow, minCol, maxRow, maxCol := s.Bounds()
+	bw := bufio.NewWriter(w)
+	for r := minRow; r <= maxRow; r++ {
+		for c := minCol; c <= maxCol; c++ {
+			if val, ok := s.cells[[2]int{r, c}]; ok {
+				bw.WriteString(format(val))
+			} else {
+				bw.WriteString(empty)
+			}
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+// String renders the grid like (*Grid).String with unset cells as .
+func (s *SparseGrid[T]) String() string {
+	var sb strings.Builder
+	s.Write(&sb, ".", formatCell[T])
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,82 @@
+package grid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/grid"
+)
+
+func TestSparseGridBounds(t *testing.T) {
+	s := grid.NewSparse[bool]()
+	if s.Area() != 0 {
+		t.Errorf("empty Area() = %d, want 0", s.Area())
+	}
+
+	// only negative coordinates, the bounds must not include 0
+	s.Set(-3, -5, true)
+	s.Set(-1, -2, true)
+	if minRow, minCol, maxRow, maxCol := s.Bounds(); minRow != -3 || minCol != -5 || maxRow != -1 || maxCol != -2 {
+		t.Errorf("Bounds() = %d, %d, %d, %d, want -3, -5, -1, -2", minRow, minCol, maxRow, maxCol)
+	}
+	if got := s.Area(); got != 12 {
+		t.Errorf("Area() = %d, want 12", got)
+	}
+
+	s.Delete(-3, -5)
+	if minRow, minCol, maxRow, maxCol := s.Bounds(); minRow != -1 || minCol != -2 || maxRow != -1 || maxCol != -2 {
+		t.Errorf("Bounds() after Delete = %d, %d, %d, %d, want -1, -2, -1, -2", minRow, minCol, maxRow, maxCol)
+	}
+	if s.Len() != 1 || s.Has(-3, -5) {
+		t.Errorf("Delete(-3, -5) did not remove the cell")
+	}
+}
+
+func TestSparseGridWrite(t *testing.T) {
+	s := grid.ParseSparse(".#.\n#..", func(r rune) bool { return r == '#' }, func(r rune) int { return 1 })
+	s.Set(-1, 3, 2)
+
+	var sb strings.Builder
+	err := s.Write(&sb, " ", func(val int) string {
+		return strings.Repeat("x", val)
+	})
+	if err != nil {
+		t.Fatalf("Write() unexpected error %v", err)
+	}
+	if got, want := sb.String(), "   xx\n x  \nx   \n"; got != want {
+		t.Errorf("Write() = %q, want %q", got, want)
+	}
+	if got, want := s.String(), "...2\n.1..\n1..."; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestSparseGridNeighborsAndCount(t *testing.T) {
+	s := grid.ParseSparse("##.\n.#.\n..#", func(r rune) bool { return r == '#' }, func(r rune) rune { return r })
+	if got := len(s.Neighbors4(1, 1)); got != 1 {
+		t.Errorf("len(Neighbors4(1, 1)) = %d, want 1", got)
+	}
+	if got := len(s.Neighbors8(1, 1)); got != 3 {
+		t.Errorf("len(Neighbors8(1, 1)) = %d, want 3", got)
+	}
+	if got := s.Count(func(r rune) bool { return r == '#' }); got != 4 {
+		t.Errorf("Count() = %d, want 4", got)
+	}
+}
+
+func TestSparseGridFloodFill(t *testing.T) {
+	// a closed ring with a hole in the middle
+	s := grid.ParseSparse("###\n#.#\n###", func(r rune) bool { return r == '#' }, func(r rune) bool { return true })
+	empty := func(_, _ int, _ bool, set bool) bool { return !set }
+
+	inside := s.FloodFill(1, 1, empty)
+	if len(inside) != 1 {
+		t.Errorf("FloodFill() inside = %v, want only the center", inside)
+	}
+
+	// the outside is limited to the bounds grown by one, i.e. a 5x5 ring
+	outside := s.FloodFill(-1, -1, empty)
+	if len(outside) != 16 {
+		t.Errorf("FloodFill() outside has %d cells, want 16", len(outside))
+	}
+}
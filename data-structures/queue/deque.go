@@ -0,0 +1,177 @@
+// Package queue provides a generic FIFO queue and a double ended queue, both
+// backed by a ring buffer
+package queue
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Deque is a double ended queue backed by a ring buffer, pushing and
+// popping at both ends takes amortized O(1)
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// NewDeque returns a deque with the given items from front to back
+func NewDeque[T any](items ...T) *Deque[T] {
+	d := &Deque[T]{}
+	d.PushBack(items...)
+	return d
+}
+
+// Len returns the number of items in the deque
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// PushBack appends the items at the back in order
+func (d *Deque[T]) PushBack(items ...T) {
+	d.grow(len(items))
+	for _, item := range items {
+		d.buf[d.index(d.count)] = item
+		d.count++
+	}
+}
+
+// PushFront prepends the items at the front, they keep their order, so the
+// first item ends up at the front
+func (d *Deque[T]) PushFront(items ...T) {
+	d.grow(len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		d.head = d.index(len(d.buf) - 1)
+		d.buf[d.head] = items[i]
+		d.count++
+	}
+}
+
+// PopFront removes and returns the front item, ok is false if the deque is
+// empty
+func (d *Deque[T]) PopFront() (item T, ok bool) {
+	if d.count == 0 {
+		return item, false
+	}
+	var zero T
+	item, d.buf[d.head] = d.buf[d.head], zero
+	d.head = d.index(1)
+	d.count--
+	return item, true
+}
+
+// PopBack removes and returns the back item, ok is false if the deque is
+// empty
+func (d *Deque[T]) PopBack() (item T, ok bool) {
+	if d.count == 0 {
+		return item, false
+	}
+	var zero T
+	last := d.index(d.count - 1)
+	item, d.buf[last] = d.buf[last], zero
+	d.count--
+	return item, true
+}
+
+// PopFrontN removes the first n items and returns them from front to back
+// It panics if there are less than n items
+func (d *Deque[T]) PopFrontN(n int) []T {
+	d.mustHave(n)
+	items := make([]T, n)
+	for i := range items {
+		items[i], _ = d.PopFront()
+	}
+	return items
+}
+
+// PopBackN removes the last n items and returns them from front to back
+// It panics if there are less than n items
+func (d *Deque[T]) PopBackN(n int) []T {
+	d.mustHave(n)
+	items := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		items[i], _ = d.PopBack()
+	}
+	return items
+}
+
+// Front returns the front item without removing it, ok is false if the
+// deque is empty
+func (d *Deque[T]) Front() (item T, ok bool) {
+	if d.count == 0 {
+		return item, false
+	}
+	return d.buf[d.head], true
+}
+
+// Back returns the back item without removing it, ok is false if the deque
+// is empty
+func (d *Deque[T]) Back() (item T, ok bool) {
+	if d.count == 0 {
+		return item, false
+	}
+	return d.buf[d.index(d.count-1)], true
+}
+
+// At returns the i-th item from the front, it panics if i is out of range
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.count {
+		panic(fmt.Sprintf("index %d out of range for %d items", i, d.count))
+	}
+	return d.buf[d.index(i)]
+}
+
+// Items returns a copy of all items from front to back
+func (d *Deque[T]) Items() []T {
+	items := make([]T, d.count)
+	for i := range items {
+		items[i] = d.buf[d.index(i)]
+	}
+	return items
+}
+
+// String lists the items from front to back, so the back is last, e.g.
+// [a b c] for c at the back
+func (d *Deque[T]) String() string {
+	parts := make([]string, d.count)
+	for i := range parts {
+		parts[i] = fmt.Sprint(d.buf[d.index(i)])
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// index maps the i-th item from the front to its position in buf
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// grow makes room for n more items, at least doubling the buffer to keep
+// pushes amortized O(1)
+func (d *Deque[T]) grow(n int) {
+	if d.count+n <= len(d.buf) {
+		return
+	}
+	size := 2 * len(d.buf)
+	if size < d.count+n {
+		size = d.count + n
+	}
+	if size < 8 {
+		size = 8
+	}
+	buf := make([]T, size)
+	if d.count > 0 {
+		if end := d.head + d.count; end <= len(d.buf) {
+			copy(buf, d.buf[d.head:end])
+		} else {
+			n := copy(buf, d.buf[d.head:])
+			copy(buf[n:], d.buf[:end-len(d.buf)])
+		}
+	}
+	d.buf, d.head = buf, 0
+}
+
+func (d *Deque[T]) mustHave(n int) {
+	if n < 0 || n > d.count {
+		panic(fmt.Sprintf("cannot pop %d of %d items", n, d.count))
+	}
+}
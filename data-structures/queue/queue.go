@@ -0,0 +1,59 @@
+package queue
+
+// Queue is a FIFO queue backed by a ring buffer, e.g. for the frontier of a
+// breadth first search. Pushing and popping takes amortized O(1), unlike
+// reslicing a slice it reuses the memory of popped items
+type Queue[T any] struct {
+	d Deque[T]
+}
+
+// New returns a queue with the given items, the first one is popped first
+func New[T any](items ...T) *Queue[T] {
+	q := &Queue[T]{}
+	q.Push(items...)
+	return q
+}
+
+// Push appends the items at the back in order
+func (q *Queue[T]) Push(items ...T) {
+	q.d.PushBack(items...)
+}
+
+// Pop removes and returns the front item, ok is false if the queue is empty
+func (q *Queue[T]) Pop() (item T, ok bool) {
+	return q.d.PopFront()
+}
+
+// PopN removes the first n items and returns them in queue order. It panics
+// if there are less than n items
+func (q *Queue[T]) PopN(n int) []T {
+	return q.d.PopFrontN(n)
+}
+
+// Peek returns the front item without removing it, ok is false if the queue
+// is empty
+func (q *Queue[T]) Peek() (item T, ok bool) {
+	return q.d.Front()
+}
+
+// Len returns the number of items in the queue
+func (q *Queue[T]) Len() int {
+	return q.d.Len()
+}
+
+// Items returns a copy of all items from front to back
+func (q *Queue[T]) Items() []T {
+	return q.d.Items()
+}
+
+// MoveTo moves the first n items to the back of dst, keeping their order
+// It panics if there are less than n items
+func (q *Queue[T]) MoveTo(dst *Queue[T], n int) {
+	dst.Push(q.PopN(n)...)
+}
+
+// String lists the items from front to back, so the back is last, e.g.
+// [a b c] for c at the back
+func (q *Queue[T]) String() string {
+	return q.d.String()
+}
@@ -0,0 +1,81 @@
+package queue_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/queue"
+)
+
+func TestQueue(t *testing.T) {
+	q := queue.New(1, 2)
+	// interleave pushes and pops so the ring buffer wraps around and grows
+	want := 1
+	next := 3
+	for it := 0; it < 100; it++ {
+		q.Push(next, next+1)
+		next += 2
+		got, ok := q.Pop()
+		if !ok || got != want {
+			t.Fatalf("Pop() = %v, %t, want %d", got, ok, want)
+		}
+		want++
+	}
+	if q.Len() != 102 {
+		t.Errorf("Len() = %d, want 102", q.Len())
+	}
+	if front, _ := q.Peek(); front != 101 {
+		t.Errorf("Peek() = %d, want 101", front)
+	}
+
+	dst := queue.New(0)
+	q.MoveTo(dst, 3)
+	if got := dst.Items(); !reflect.DeepEqual(got, []int{0, 101, 102, 103}) {
+		t.Errorf("MoveTo() = %v", got)
+	}
+	if got := dst.String(); got != "[0 101 102 103]" {
+		t.Errorf("String() = %v", got)
+	}
+	dst.PopN(4)
+	if _, ok := dst.Pop(); ok {
+		t.Errorf("Pop() on empty queue should not be ok")
+	}
+}
+
+func TestDeque(t *testing.T) {
+	d := queue.NewDeque(3, 4)
+	d.PushFront(1, 2)
+	d.PushBack(5, 6)
+	if got := d.Items(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Items() = %v", got)
+	}
+	if front, _ := d.Front(); front != 1 {
+		t.Errorf("Front() = %d", front)
+	}
+	if back, _ := d.Back(); back != 6 || d.At(2) != 3 {
+		t.Errorf("Back() = %d, At(2) = %d", back, d.At(2))
+	}
+	if got := d.PopBackN(2); !reflect.DeepEqual(got, []int{5, 6}) {
+		t.Errorf("PopBackN() = %v", got)
+	}
+	if got := d.PopFrontN(2); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("PopFrontN() = %v", got)
+	}
+	if back, _ := d.PopBack(); back != 4 {
+		t.Errorf("PopBack() = %d", back)
+	}
+	if front, _ := d.PopFront(); front != 3 {
+		t.Errorf("PopFront() = %d", front)
+	}
+	if _, ok := d.PopFront(); ok || d.Len() != 0 {
+		t.Errorf("PopFront() on empty deque should not be ok")
+	}
+
+	// pushing to the front of a full buffer has to keep the order
+	for it := 0; it < 20; it++ {
+		d.PushFront(it)
+	}
+	if got, _ := d.Back(); got != 0 || d.At(0) != 19 {
+		t.Errorf("Back() = %d, At(0) = %d", got, d.At(0))
+	}
+}
@@ -0,0 +1,217 @@
+package ring
+
+import "math/rand"
+
+// IndexedNode is a stable handle to an element of an Indexed list
+type IndexedNode[T any] struct {
+	Value               T
+	left, right, parent *IndexedNode[T]
+	priority            int64
+	size                int
+	list                *Indexed[T]
+}
+
+func (n *IndexedNode[T]) sizeOf() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *IndexedNode[T]) update() {
+	n.size = 1 + n.left.sizeOf() + n.right.sizeOf()
+	if n.left != nil {
+		n.left.parent = n
+	}
+	if n.right != nil {
+		n.right.parent = n
+	}
+}
+
+// Indexed is a circular list backed by an order statistic tree (an implicit
+// treap). Compared to List it finds the position of a node and the node at a
+// position in O(log n), so moving by large k takes O(log n) instead of O(n)
+type Indexed[T any] struct {
+	root *IndexedNode[T]
+	rand *rand.Rand
+}
+
+// NewIndexed returns a list of the given values and the handles of their
+// nodes in the original order
+func NewIndexed[T any](values ...T) (*Indexed[T], []*IndexedNode[T]) {
+	l := &Indexed[T]{rand: rand.New(rand.NewSource(1))}
+	nodes := make([]*IndexedNode[T], len(values))
+	for i, v := range values {
+		nodes[i] = l.PushBack(v)
+	}
+	return l, nodes
+}
+
+// Len returns the number of nodes in the list
+func (l *Indexed[T]) Len() int {
+	return l.root.sizeOf()
+}
+
+// PushBack appends a new node with value v
+func (l *Indexed[T]) PushBack(v T) *IndexedNode[T] {
+	n := &IndexedNode[T]{Value: v, priority: l.rand.Int63(), size: 1, list: l}
+	l.root = merge(l.root, n)
+	l.root.parent = nil
+	return n
+}
+
+// Index returns the current position of n, counted from the front
+func (l *Indexed[T]) Index(n *IndexedNode[T]) int {
+	l.mustContain(n)
+	index := n.left.sizeOf()
+	for ; n.parent != nil; n = n.parent {
+		if n.parent.right == n {
+			index += n.parent.left.sizeOf() + 1
+		}
+	}
+	return index
+}
+
+// At returns the node at position i, which wraps around in both directions
+func (l *Indexed[T]) At(i int) *IndexedNode[T] {
+	if l.Len() == 0 {
+		panic("At on empty list")
+	}
+	i %= l.Len()
+	if i < 0 {
+		i += l.Len()
+	}
+	n := l.root
+	for {
+		switch leftSize := n.left.sizeOf(); {
+		case i < leftSize:
+			n = n.left
+		case i == leftSize:
+			return n
+		default:
+			i -= leftSize + 1
+			n = n.right
+		}
+	}
+}
+
+// Remove unlinks n from the list and returns its value
+func (l *Indexed[T]) Remove(n *IndexedNode[T]) T {
+	index := l.Index(n)
+	before, rest := split(l.root, index)
+	_, after := split(rest, 1)
+	l.root = merge(before, after)
+	if l.root != nil {
+		l.root.parent = nil
+	}
+	n.list = nil
+	return n.Value
+}
+
+// Move moves n k positions forward (backwards for negative k), with the
+// same semantics as List.Move, in O(log n)
+func (l *Indexed[T]) Move(n *IndexedNode[T], k int) {
+	others := l.Len() - 1
+	index := l.Index(n)
+	if others <= 0 {
+		return
+	}
+	before, rest := split(l.root, index)
+	_, after := split(rest, 1)
+	remaining := merge(before, after)
+
+	target := (index + k) % others
+	if target < 0 {
+		target += others
+	}
+	n.left, n.right, n.parent = nil, nil, nil
+	n.size = 1
+	before, after = split(remaining, target)
+	l.root = merge(merge(before, n), after)
+	l.root.parent = nil
+}
+
+// Find returns the first node from the front whose value matches, nil if
+// there is none
+func (l *Indexed[T]) Find(match func(T) bool) *IndexedNode[T] {
+	var found *IndexedNode[T]
+	l.Iterate(func(n *IndexedNode[T]) bool {
+		if match(n.Value) {
+			found = n
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// Iterate calls fn for every node in order starting at the front, it stops
+// early if fn returns false
+func (l *Indexed[T]) Iterate(fn func(*IndexedNode[T]) bool) {
+	var walk func(n *IndexedNode[T]) bool
+	walk = func(n *IndexedNode[T]) bool {
+		if n == nil {
+			return true
+		}
+		return walk(n.left) && fn(n) && walk(n.right)
+	}
+	walk(l.root)
+}
+
+// Values returns all values starting at the front
+func (l *Indexed[T]) Values() []T {
+	values := make([]T, 0, l.Len())
+	l.Iterate(func(n *IndexedNode[T]) bool {
+		values = append(values, n.Value)
+		return true
+	})
+	return values
+}
+
+func (l *Indexed[T]) mustContain(n *IndexedNode[T]) {
+	if n == nil || n.list != l {
+		panic("node is not part of this list")
+	}
+}
+
+// split splits the tree into the first k nodes and the rest, the parent of
+// the returned roots is not updated
+func split[T any](n *IndexedNode[T], k int) (*IndexedNode[T], *IndexedNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if leftSize := n.left.sizeOf(); k <= leftSize {
+		left, right := split(n.left, k)
+		n.left = right
+		n.update()
+		if left != nil {
+			left.parent = nil
+		}
+		return left, n
+	}
+	left, right := split(n.right, k-n.left.sizeOf()-1)
+	n.right = left
+	n.update()
+	if right != nil {
+		right.parent = nil
+	}
+	return n, right
+}
+
+// merge concatenates two trees, all nodes of a come before the ones of b
+func merge[T any](a, b *IndexedNode[T]) *IndexedNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = merge(a.right, b)
+		a.update()
+		return a
+	}
+	b.left = merge(a, b.left)
+	b.update()
+	return b
+}
@@ -0,0 +1,205 @@
+// Package ring provides circular lists whose elements can be moved around
+// while keeping stable handles to them, e.g. for mixing a list of numbers
+package ring
+
+// Node is a stable handle to an element of a List, it stays valid while the
+// element is moved around
+type Node[T any] struct {
+	Value      T
+	prev, next *Node[T]
+	list       *List[T]
+}
+
+// Next returns the following node, wrapping around at the end
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
+}
+
+// Prev returns the preceding node, wrapping around at the start
+func (n *Node[T]) Prev() *Node[T] {
+	return n.prev
+}
+
+// Step returns the node k positions after n, negative k walk backwards
+func (n *Node[T]) Step(k int) *Node[T] {
+	if n.list != nil && n.list.len > 0 {
+		k %= n.list.len
+	}
+	for ; k > 0; k-- {
+		n = n.next
+	}
+	for ; k < 0; k++ {
+		n = n.prev
+	}
+	return n
+}
+
+// List is a circular doubly linked list with O(1) unlink and insert
+type List[T any] struct {
+	front *Node[T]
+	len   int
+}
+
+// New returns a list of the given values and the handles of their nodes in
+// the original order, so the order can be replayed after moving nodes
+func New[T any](values ...T) (*List[T], []*Node[T]) {
+	l := &List[T]{}
+	nodes := make([]*Node[T], len(values))
+	for i, v := range values {
+		nodes[i] = l.PushBack(v)
+	}
+	return l, nodes
+}
+
+// Len returns the number of nodes in the list
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first node, nil if the list is empty
+// As the list is circular, the front only matters for Values and Iterate
+func (l *List[T]) Front() *Node[T] {
+	return l.front
+}
+
+// PushBack inserts a new node with value v before the front
+func (l *List[T]) PushBack(v T) *Node[T] {
+	n := &Node[T]{Value: v, list: l}
+	if l.front == nil {
+		n.prev, n.next = n, n
+		l.front = n
+		l.len = 1
+		return n
+	}
+	l.link(n, l.front.prev)
+	return n
+}
+
+// InsertAfter inserts a new node with value v after mark
+func (l *List[T]) InsertAfter(v T, mark *Node[T]) *Node[T] {
+	l.mustContain(mark)
+	n := &Node[T]{Value: v, list: l}
+	l.link(n, mark)
+	return n
+}
+
+// Remove unlinks n from the list and returns its value
+func (l *List[T]) Remove(n *Node[T]) T {
+	l.mustContain(n)
+	l.unlink(n)
+	n.list = nil
+	return n.Value
+}
+
+// MoveAfter moves n directly after mark
+func (l *List[T]) MoveAfter(n, mark *Node[T]) {
+	l.mustContain(n)
+	l.mustContain(mark)
+	if n == mark {
+		return
+	}
+	l.unlink(n)
+	l.link(n, mark)
+}
+
+// Move moves n k positions forward (backwards for negative k). While moving
+// n is not part of the list, so k is reduced modulo Len()-1 and every step
+// takes O(1), e.g. moving by Len()-1 puts n back into the same place
+func (l *List[T]) Move(n *Node[T], k int) {
+	l.mustContain(n)
+	others := l.len - 1
+	if others == 0 {
+		return
+	}
+	k %= others
+	if k < 0 {
+		k += others
+	}
+	if k == 0 {
+		return
+	}
+	// walk the shorter way around the remaining nodes
+	mark := n.prev
+	l.unlink(n)
+	if k <= others/2 {
+		for ; k > 0; k-- {
+			mark = mark.next
+		}
+	} else {
+		for ; k < others; k++ {
+			mark = mark.prev
+		}
+	}
+	l.link(n, mark)
+}
+
+// Find returns the first node from the front whose value matches, nil if
+// there is none
+func (l *List[T]) Find(match func(T) bool) *Node[T] {
+	var found *Node[T]
+	l.Iterate(l.front, func(n *Node[T]) bool {
+		if match(n.Value) {
+			found = n
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// Iterate calls fn for every node once, starting at from (the front if nil)
+// It stops early if fn returns false
+func (l *List[T]) Iterate(from *Node[T], fn func(*Node[T]) bool) {
+	if from == nil {
+		from = l.front
+	}
+	if from == nil {
+		return
+	}
+	l.mustContain(from)
+	n := from
+	for i := 0; i < l.len; i++ {
+		next := n.next
+		if !fn(n) {
+			return
+		}
+		n = next
+	}
+}
+
+// Values returns all values starting at the front
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.len)
+	l.Iterate(nil, func(n *Node[T]) bool {
+		values = append(values, n.Value)
+		return true
+	})
+	return values
+}
+
+// link inserts the detached n after mark
+func (l *List[T]) link(n, mark *Node[T]) {
+	n.prev, n.next = mark, mark.next
+	mark.next.prev = n
+	mark.next = n
+	l.len++
+}
+
+// unlink detaches n, moving the front along if needed
+func (l *List[T]) unlink(n *Node[T]) {
+	l.len--
+	if l.len == 0 {
+		l.front = nil
+	} else if l.front == n {
+		l.front = n.next
+	}
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev, n.next = nil, nil
+}
+
+func (l *List[T]) mustContain(n *Node[T]) {
+	if n == nil || n.list != l {
+		panic("node is not part of this list")
+	}
+}
@@ -0,0 +1,122 @@
+package ring_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/ring"
+)
+
+// naiveMove is the slice based reference implementation of Move
+func naiveMove(values []int, index, k int) []int {
+	v := values[index]
+	rest := append(append([]int{}, values[:index]...), values[index+1:]...)
+	target := (index + k) % len(rest)
+	if target < 0 {
+		target += len(rest)
+	}
+	return append(rest[:target], append([]int{v}, rest[target:]...)...)
+}
+
+// rotated returns values rotated so it starts with first, which makes lists
+// comparable independent of where their front is
+func rotated(values []int, first int) []int {
+	for i, v := range values {
+		if v == first {
+			return append(append([]int{}, values[i:]...), values[:i]...)
+		}
+	}
+	return nil
+}
+
+func TestListMix(t *testing.T) {
+	// the number mixing example, every number moves by its own value
+	values := []int{1, 2, -3, 3, -2, 0, 4}
+	list, nodes := ring.New(values...)
+	for _, n := range nodes {
+		list.Move(n, n.Value)
+	}
+	want := []int{0, 3, -2, 1, 2, -3, 4}
+	zero := list.Find(func(v int) bool { return v == 0 })
+	var got []int
+	list.Iterate(zero, func(n *ring.Node[int]) bool {
+		got = append(got, n.Value)
+		return true
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mixed = %v, want %v", got, want)
+	}
+	if sum := zero.Step(1000).Value + zero.Step(2000).Value + zero.Step(3000).Value; sum != 3 {
+		t.Errorf("grove coordinates = %d, want 3", sum)
+	}
+}
+
+func TestListAgainstSlice(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	values := make([]int, 50)
+	for i := range values {
+		values[i] = i
+	}
+	list, nodes := ring.New(values...)
+	indexed, indexedNodes := ring.NewIndexed(values...)
+	for it := 0; it < 1000; it++ {
+		moved := rnd.Intn(len(values))
+		k := rnd.Intn(1000) - 500
+
+		index := 0
+		for values[index] != moved {
+			index++
+		}
+		values = naiveMove(values, index, k)
+		list.Move(nodes[moved], k)
+		indexed.Move(indexedNodes[moved], k)
+
+		if got := rotated(list.Values(), 0); !reflect.DeepEqual(got, rotated(values, 0)) {
+			t.Fatalf("List after move %d = %v, want %v", it, got, rotated(values, 0))
+		}
+		if got := rotated(indexed.Values(), 0); !reflect.DeepEqual(got, rotated(values, 0)) {
+			t.Fatalf("Indexed after move %d = %v, want %v", it, got, rotated(values, 0))
+		}
+	}
+}
+
+func TestListInsertRemove(t *testing.T) {
+	list, nodes := ring.New("a", "b", "c")
+	list.InsertAfter("x", nodes[2])
+	list.MoveAfter(nodes[0], nodes[1])
+	if got := list.Remove(nodes[1]); got != "b" {
+		t.Errorf("Remove() = %v, want b", got)
+	}
+	if got := list.Values(); !reflect.DeepEqual(got, []string{"a", "c", "x"}) {
+		t.Errorf("Values() = %v", got)
+	}
+	if nodes[0].Prev().Value != "x" || nodes[0].Step(-2).Value != "c" {
+		t.Errorf("ring is not circular")
+	}
+	if list.Find(func(v string) bool { return v == "b" }) != nil {
+		t.Errorf("Find() found removed value")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Move() should panic for removed nodes")
+		}
+	}()
+	list.Move(nodes[1], 1)
+}
+
+func TestIndexed(t *testing.T) {
+	list, nodes := ring.NewIndexed(10, 20, 30, 40)
+	if list.Index(nodes[2]) != 2 || list.At(-1).Value != 40 || list.At(5).Value != 20 {
+		t.Errorf("Index()/At() do not match the initial order")
+	}
+	list.Remove(nodes[1])
+	list.Move(nodes[0], 1)
+	if got := list.Values(); !reflect.DeepEqual(got, []int{30, 10, 40}) {
+		t.Errorf("Values() = %v", got)
+	}
+	if list.Len() != 3 || list.Index(nodes[3]) != 2 {
+		t.Errorf("Len() = %d, Index() = %d", list.Len(), list.Index(nodes[3]))
+	}
+}
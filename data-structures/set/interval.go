@@ -0,0 +1,210 @@
+package set
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Interval is a half-open range of ints [Start, End), use Closed to create one
+// from inclusive bounds
+type Interval struct {
+	Start int
+	End   int
+}
+
+// Closed returns the interval covering lo through hi, both inclusive
+func Closed(lo, hi int) Interval {
+	return Interval{lo, hi + 1}
+}
+
+// HalfOpen returns the interval covering lo through hi, hi exclusive
+func HalfOpen(lo, hi int) Interval {
+	return Interval{lo, hi}
+}
+
+// Len returns the number of ints in the interval, 0 if it is empty
+func (iv Interval) Len() int {
+	if iv.Empty() {
+		return 0
+	}
+	return iv.End - iv.Start
+}
+
+// Empty returns true if the interval does not contain any ints
+func (iv Interval) Empty() bool {
+	return iv.End <= iv.Start
+}
+
+// Contains returns true if x is within the interval
+func (iv Interval) Contains(x int) bool {
+	return x >= iv.Start && x < iv.End
+}
+
+// ContainsInterval returns true if other is fully within the interval
+func (iv Interval) ContainsInterval(other Interval) bool {
+	return other.Empty() || (other.Start >= iv.Start && other.End <= iv.End)
+}
+
+// Overlaps returns true if both intervals have at least one int in common
+func (iv Interval) Overlaps(other Interval) bool {
+	return !iv.Intersect(other).Empty()
+}
+
+// Intersect returns the interval of ints in both intervals, might be empty
+func (iv Interval) Intersect(other Interval) Interval {
+	start, end := iv.Start, iv.End
+	if other.Start > start {
+		start = other.Start
+	}
+	if other.End < end {
+		end = other.End
+	}
+	return Interval{start, end}
+}
+
+func (iv Interval) String() string {
+	return fmt.Sprintf("[%d,%d)", iv.Start, iv.End)
+}
+
+// IntervalSet maintains a sorted list of disjoint, non-adjacent intervals.
+// Overlapping or touching intervals are merged when added
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewIntervalSet initializes a set with the given intervals
+func NewIntervalSet(intervals ...Interval) *IntervalSet {
+	s := &IntervalSet{}
+	for _, iv := range intervals {
+		s.Add(iv)
+	}
+	return s
+}
+
+// Add an interval to the set, merging it with any overlapping or adjacent
+// intervals
+func (s *IntervalSet) Add(iv Interval) {
+	if iv.Empty() {
+		return
+	}
+	// first interval that ends at or after the start, i.e. could be merged
+	first := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].End >= iv.Start
+	})
+	last := first
+	for last < len(s.intervals) && s.intervals[last].Start <= iv.End {
+		if s.intervals[last].Start < iv.Start {
+			iv.Start = s.intervals[last].Start
+		}
+		if s.intervals[last].End > iv.End {
+			iv.End = s.intervals[last].End
+		}
+		last++
+	}
+
+	merged := append([]Interval{}, s.intervals[:first]...)
+	merged = append(merged, iv)
+	s.intervals = append(merged, s.intervals[last:]...)
+}
+
+// Remove all ints in the interval from the set, splitting intervals if needed
+func (s *IntervalSet) Remove(iv Interval) {
+	if iv.Empty() {
+		return
+	}
+	var kept []Interval
+	for _, existing := range s.intervals {
+		if !existing.Overlaps(iv) {
+			kept = append(kept, existing)
+			continue
+		}
+		if left := (Interval{existing.Start, iv.Start}); !left.Empty() {
+			kept = append(kept, left)
+		}
+		if right := (Interval{iv.End, existing.End}); !right.Empty() {
+			kept = append(kept, right)
+		}
+	}
+	s.intervals = kept
+}
+
+// Contains returns true if x is covered by any interval in the set
+func (s *IntervalSet) Contains(x int) bool {
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].End > x
+	})
+	return i < len(s.intervals) && s.intervals[i].Contains(x)
+}
+
+// ContainsInterval returns true if every int of iv is covered by the set
+func (s *IntervalSet) ContainsInterval(iv Interval) bool {
+	if iv.Empty() {
+		return true
+	}
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].End > iv.Start
+	})
+	return i < len(s.intervals) && s.intervals[i].ContainsInterval(iv)
+}
+
+// Overlaps returns true if any int of iv is covered by the set
+func (s *IntervalSet) Overlaps(iv Interval) bool {
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].End > iv.Start
+	})
+	return i < len(s.intervals) && s.intervals[i].Overlaps(iv)
+}
+
+// Len returns the total number of ints covered by the set
+func (s *IntervalSet) Len() int {
+	var total int
+	for _, iv := range s.intervals {
+		total += iv.Len()
+	}
+	return total
+}
+
+// Intervals returns a copy of the sorted, disjoint intervals in the set
+func (s *IntervalSet) Intervals() []Interval {
+	return append([]Interval{}, s.intervals...)
+}
+
+// Gaps returns the intervals within bound that are NOT covered by the set
+func (s *IntervalSet) Gaps(bound Interval) []Interval {
+	var gaps []Interval
+	current := bound.Start
+	for _, iv := range s.intervals {
+		if iv.End <= current {
+			continue
+		}
+		if iv.Start >= bound.End {
+			break
+		}
+		if iv.Start > current {
+			gaps = append(gaps, Interval{current, iv.Start})
+		}
+		current = iv.End
+	}
+	if current < bound.End {
+		gaps = append(gaps, Interval{current, bound.End})
+	}
+	return gaps
+}
+
+// Intersect returns a new set with the ints covered by both sets
+func (s *IntervalSet) Intersect(other *IntervalSet) *IntervalSet {
+	result := &IntervalSet{}
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(other.intervals) {
+		if overlap := s.intervals[i].Intersect(other.intervals[j]); !overlap.Empty() {
+			result.intervals = append(result.intervals, overlap)
+		}
+		// advance whichever interval ends first
+		if s.intervals[i].End < other.intervals[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package set_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/set"
+)
+
+func TestIntervalSetAdd(t *testing.T) {
+	tests := []struct {
+		name  string
+		toAdd []set.Interval
+		want  []set.Interval
+	}{
+		{"disjoint", []set.Interval{set.Closed(5, 7), set.Closed(0, 2)}, []set.Interval{{0, 3}, {5, 8}}},
+		{"overlapping", []set.Interval{set.Closed(0, 4), set.Closed(2, 7)}, []set.Interval{{0, 8}}},
+		{"adjacent", []set.Interval{set.HalfOpen(0, 3), set.HalfOpen(3, 5)}, []set.Interval{{0, 5}}},
+		{"bridging", []set.Interval{set.Closed(0, 1), set.Closed(5, 6), set.Closed(10, 11), set.Closed(1, 5)}, []set.Interval{{0, 7}, {10, 12}}},
+		{"contained", []set.Interval{set.Closed(0, 10), set.Closed(3, 4)}, []set.Interval{{0, 11}}},
+		{"negative", []set.Interval{set.Closed(-10, -5), set.Closed(-3, 0)}, []set.Interval{{-10, -4}, {-3, 1}}},
+		{"empty", []set.Interval{set.HalfOpen(3, 3)}, []set.Interval{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := set.NewIntervalSet(tt.toAdd...).Intervals()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intervals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalSetQueries(t *testing.T) {
+	s := set.NewIntervalSet(set.Closed(0, 4), set.Closed(10, 14))
+
+	if got := s.Len(); got != 10 {
+		t.Errorf("Len() = %d, want 10", got)
+	}
+	for x, want := range map[int]bool{-1: false, 0: true, 4: true, 5: false, 9: false, 10: true, 14: true, 15: false} {
+		if got := s.Contains(x); got != want {
+			t.Errorf("Contains(%d) = %v, want %v", x, got, want)
+		}
+	}
+	if !s.ContainsInterval(set.Closed(1, 3)) || s.ContainsInterval(set.Closed(3, 11)) {
+		t.Errorf("ContainsInterval() wrong for [1,3] or [3,11]")
+	}
+	if !s.Overlaps(set.Closed(3, 11)) || s.Overlaps(set.Closed(5, 9)) {
+		t.Errorf("Overlaps() wrong for [3,11] or [5,9]")
+	}
+
+	gaps := s.Gaps(set.Closed(-2, 20))
+	want := []set.Interval{{-2, 0}, {5, 10}, {15, 21}}
+	if !reflect.DeepEqual(gaps, want) {
+		t.Errorf("Gaps() = %v, want %v", gaps, want)
+	}
+	if gaps := s.Gaps(set.Closed(1, 3)); len(gaps) != 0 {
+		t.Errorf("Gaps() within a covered interval = %v, want none", gaps)
+	}
+}
+
+func TestIntervalSetRemove(t *testing.T) {
+	s := set.NewIntervalSet(set.Closed(0, 10), set.Closed(20, 30))
+	s.Remove(set.Closed(5, 22))
+	want := []set.Interval{{0, 5}, {23, 31}}
+	if got := s.Intervals(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Intervals() after Remove = %v, want %v", got, want)
+	}
+}
+
+func TestIntervalSetIntersect(t *testing.T) {
+	a := set.NewIntervalSet(set.Closed(0, 10), set.Closed(20, 30))
+	b := set.NewIntervalSet(set.Closed(5, 25), set.Closed(28, 40))
+	want := []set.Interval{{5, 11}, {20, 26}, {28, 31}}
+	if got := a.Intersect(b).Intervals(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersect() = %v, want %v", got, want)
+	}
+}
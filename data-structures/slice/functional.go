@@ -0,0 +1,51 @@
+package slice
+
+// Map returns a new slice with fn applied to every element
+func Map[T, U any](sli []T, fn func(T) U) []U {
+	result := make([]U, len(sli))
+	for i, v := range sli {
+		result[i] = fn(v)
+	}
+	return result
+}
+
+// Filter returns a new slice of all elements for which keep returns true
+func Filter[T any](sli []T, keep func(T) bool) []T {
+	var result []T
+	for _, v := range sli {
+		if keep(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Reduce folds the slice from left to right into a single value, starting
+// with initial
+func Reduce[T, U any](sli []T, initial U, fn func(acc U, v T) U) U {
+	acc := initial
+	for _, v := range sli {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// GroupBy groups the elements by the key returned for them, every group
+// maintains the original order
+func GroupBy[T any, K comparable](sli []T, key func(T) K) map[K][]T {
+	groups := map[K][]T{}
+	for _, v := range sli {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// Counts returns how often every value occurs in the slice
+func Counts[T comparable](sli []T) map[T]int {
+	counts := map[T]int{}
+	for _, v := range sli {
+		counts[v]++
+	}
+	return counts
+}
@@ -0,0 +1,35 @@
+package slice_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/slice"
+)
+
+func TestMapFilterReduce(t *testing.T) {
+	nums := []int{1, 2, 3, 4, 5}
+	if got := slice.Map(nums, strconv.Itoa); !reflect.DeepEqual(got, []string{"1", "2", "3", "4", "5"}) {
+		t.Errorf("Map() = %v", got)
+	}
+	even := func(n int) bool { return n%2 == 0 }
+	if got := slice.Filter(nums, even); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("Filter() = %v", got)
+	}
+	concat := func(acc string, n int) string { return acc + strconv.Itoa(n) }
+	if got := slice.Reduce(nums, ">", concat); got != ">12345" {
+		t.Errorf("Reduce() = %v", got)
+	}
+}
+
+func TestGroupByAndCounts(t *testing.T) {
+	words := []string{"ab", "c", "de", "f", "ab"}
+	want := map[int][]string{1: {"c", "f"}, 2: {"ab", "de", "ab"}}
+	if got := slice.GroupBy(words, func(w string) int { return len(w) }); !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+	if got := slice.Counts(words); !reflect.DeepEqual(got, map[string]int{"ab": 2, "c": 1, "de": 1, "f": 1}) {
+		t.Errorf("Counts() = %v", got)
+	}
+}
@@ -1,9 +1,9 @@
 package slice
 
-// DedupeStrings returns a new slice with duplicates removed, maintains original order
-func DedupeStrings(sli []string) []string {
-	var result []string
-	seen := map[string]bool{}
+// Dedupe returns a new slice with duplicates removed, maintains original order
+func Dedupe[T comparable](sli []T) []T {
+	var result []T
+	seen := map[T]bool{}
 	for _, v := range sli {
 		if !seen[v] {
 			result = append(result, v)
@@ -13,23 +13,11 @@ func DedupeStrings(sli []string) []string {
 	return result
 }
 
-// DedupeInts returns a new slice with duplicates removed, maintains original order
-func DedupeInts(sli []int) []int {
-	var result []int
-	seen := map[int]bool{}
-	for _, v := range sli {
-		if !seen[v] {
-			result = append(result, v)
-			seen[v] = true
-		}
-	}
-	return result
-}
-
-// IntersectionStrings returns a slice of values in both argument slices, deduped
-func IntersectionStrings(sli1, sli2 []string) []string {
-	var result []string
-	seen := map[string]bool{}
+// Intersection returns a slice of values in both argument slices, deduped,
+// in the order of sli2
+func Intersection[T comparable](sli1, sli2 []T) []T {
+	var result []T
+	seen := map[T]bool{}
 	for _, v := range sli1 {
 		seen[v] = true
 	}
@@ -42,9 +30,9 @@ func IntersectionStrings(sli1, sli2 []string) []string {
 	return result
 }
 
-// RemoveAllStrings returns a new slice with all instances of a given string removed
-func RemoveAllStrings(sli []string, val string) []string {
-	var result []string
+// RemoveAll returns a new slice with all instances of a given value removed
+func RemoveAll[T comparable](sli []T, val T) []T {
+	var result []T
 	for _, v := range sli {
 		if v != val {
 			result = append(result, v)
@@ -53,41 +41,69 @@ func RemoveAllStrings(sli []string, val string) []string {
 	return result
 }
 
+// Splice removes a given number of elements starting at a given index, in
+// place. If index + items >= len(sli) everything from index on is removed
+func Splice[T any](sli []T, index int, items int) []T {
+	if items < 0 {
+		panic("cannot splice negative number of items")
+	}
+	if index < 0 || index > len(sli) {
+		panic("splice index out of range")
+	}
+	if index+items >= len(sli) {
+		return sli[:index]
+	}
+	n := copy(sli[index:], sli[index+items:])
+	return sli[:index+n]
+}
+
+// DedupeStrings returns a new slice with duplicates removed, maintains original order
+//
+// Deprecated in favor of the generic Dedupe
+func DedupeStrings(sli []string) []string {
+	return Dedupe(sli)
+}
+
+// DedupeInts returns a new slice with duplicates removed, maintains original order
+//
+// Deprecated in favor of the generic Dedupe
+func DedupeInts(sli []int) []int {
+	return Dedupe(sli)
+}
+
+// IntersectionStrings returns a slice of values in both argument slices, deduped
+//
+// Deprecated in favor of the generic Intersection
+func IntersectionStrings(sli1, sli2 []string) []string {
+	return Intersection(sli1, sli2)
+}
+
+// RemoveAllStrings returns a new slice with all instances of a given string removed
+//
+// Deprecated in favor of the generic RemoveAll
+func RemoveAllStrings(sli []string, val string) []string {
+	return RemoveAll(sli, val)
+}
+
 // RemoveAllInts returns a new slice with all instances of a given int removed
+//
+// Deprecated in favor of the generic RemoveAll
 func RemoveAllInts(sli []int, val int) []int {
-	var result []int
-	for _, v := range sli {
-		if v != val {
-			result = append(result, v)
-		}
-	}
-	return result
+	return RemoveAll(sli, val)
 }
 
 // SpliceStrings removes a given number of elements starting at a given index
 // if index + items >= len(sli) it does not throw an error
+//
+// Deprecated in favor of the generic Splice
 func SpliceStrings(sli []string, index int, items int) []string {
-	if items < 0 {
-		panic("cannot splice negative number of items")
-	}
-	if index+items >= len(sli) {
-		return sli[:index]
-	}
-	copy(sli[index:], sli[index+items:])
-	sli = sli[:len(sli)-items]
-	return sli
+	return Splice(sli, index, items)
 }
 
 // SpliceInts removes a given number of elements starting at a given index
 // if index + items >= len(sli) it does not throw an error
+//
+// Deprecated in favor of the generic Splice
 func SpliceInts(sli []int, index int, items int) []int {
-	if items < 0 {
-		panic("cannot splice negative number of items")
-	}
-	if index+items >= len(sli) {
-		return sli[:index]
-	}
-	copy(sli[index:], sli[index+items:])
-	sli = sli[:len(sli)-items]
-	return sli
+	return Splice(sli, index, items)
 }
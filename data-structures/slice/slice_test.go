@@ -62,3 +62,34 @@ func TestSpliceString(t *testing.T) {
 		})
 	}
 }
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		name         string
+		sli          []int
+		index, items int
+		want         []int
+	}{
+		{"middle leaves tail", []int{1, 2, 3, 4, 5}, 1, 2, []int{1, 4, 5}},
+		{"up to end", []int{1, 2, 3, 4, 5}, 3, 2, []int{1, 2, 3}},
+		{"past end", []int{1, 2, 3}, 2, 5, []int{1, 2}},
+		{"nothing", []int{1, 2, 3}, 1, 0, []int{1, 2, 3}},
+		{"at len", []int{1, 2, 3}, 3, 1, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Splice(tt.sli, tt.index, tt.items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Splice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveAllAndDedupe(t *testing.T) {
+	if got := slice.RemoveAll([]int{1, 2, 1, 3}, 1); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("RemoveAll() = %v", got)
+	}
+	if got := slice.Dedupe([][2]int{{1, 2}, {0, 0}, {1, 2}}); !reflect.DeepEqual(got, [][2]int{{1, 2}, {0, 0}}) {
+		t.Errorf("Dedupe() = %v", got)
+	}
+}
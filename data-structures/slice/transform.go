@@ -0,0 +1,100 @@
+package slice
+
+// Pair holds the elements at the same index of two zipped slices
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs up the elements at the same index, the result is as long as the
+// shorter slice
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	result := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		result[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return result
+}
+
+// Chunk splits the slice into consecutive parts of the given size, the last
+// one may be shorter. The chunks share memory with sli, but appending to one
+// does not overwrite the next
+func Chunk[T any](sli []T, size int) [][]T {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+	var result [][]T
+	for start := 0; start < len(sli); start += size {
+		end := start + size
+		if end > len(sli) {
+			end = len(sli)
+		}
+		result = append(result, sli[start:end:end])
+	}
+	return result
+}
+
+// Windows returns all overlapping windows of n consecutive elements, e.g.
+// the last four characters when searching for a start marker. The windows
+// share memory with sli, but appending to one does not overwrite the next
+func Windows[T any](sli []T, n int) [][]T {
+	if n <= 0 {
+		panic("window size must be positive")
+	}
+	var result [][]T
+	for start := 0; start+n <= len(sli); start++ {
+		result = append(result, sli[start:start+n:start+n])
+	}
+	return result
+}
+
+// Reverse returns a new slice with the elements in reverse order
+func Reverse[T any](sli []T) []T {
+	result := make([]T, len(sli))
+	for i, v := range sli {
+		result[len(sli)-1-i] = v
+	}
+	return result
+}
+
+// Rotate returns a new slice rotated to the left by k, so the element at
+// index k becomes the first one. Negative k rotate to the right
+func Rotate[T any](sli []T, k int) []T {
+	result := make([]T, len(sli))
+	if len(sli) == 0 {
+		return result
+	}
+	k %= len(sli)
+	if k < 0 {
+		k += len(sli)
+	}
+	n := copy(result, sli[k:])
+	copy(result[n:], sli[:k])
+	return result
+}
+
+// Transpose returns a new grid with rows and columns swapped, all rows must
+// have the same length
+func Transpose[T any](grid [][]T) [][]T {
+	if len(grid) == 0 {
+		return [][]T{}
+	}
+	cols := len(grid[0])
+	result := make([][]T, cols)
+	for col := range result {
+		result[col] = make([]T, len(grid))
+	}
+	for row, line := range grid {
+		if len(line) != cols {
+			panic("cannot transpose rows of different length")
+		}
+		for col, v := range line {
+			result[col][row] = v
+		}
+	}
+	return result
+}
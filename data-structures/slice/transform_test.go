@@ -0,0 +1,63 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/slice"
+)
+
+func TestChunkAndWindows(t *testing.T) {
+	nums := []int{1, 2, 3, 4, 5}
+	if got := slice.Chunk(nums, 2); !reflect.DeepEqual(got, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("Chunk() = %v", got)
+	}
+	windows := slice.Windows(nums, 3)
+	if !reflect.DeepEqual(windows, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}) {
+		t.Errorf("Windows() = %v", windows)
+	}
+	_ = append(windows[0], 42)
+	if nums[3] != 4 {
+		t.Errorf("appending to a window overwrote the input")
+	}
+	if got := slice.Windows(nums, 6); len(got) != 0 {
+		t.Errorf("Windows() larger than input = %v, want none", got)
+	}
+}
+
+func TestZip(t *testing.T) {
+	got := slice.Zip([]int{1, 2, 3}, []string{"a", "b"})
+	want := []slice.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip() = %v, want %v", got, want)
+	}
+}
+
+func TestReverseAndRotate(t *testing.T) {
+	nums := []int{1, 2, 3, 4, 5}
+	if got := slice.Reverse(nums); !reflect.DeepEqual(got, []int{5, 4, 3, 2, 1}) || nums[0] != 1 {
+		t.Errorf("Reverse() = %v, input %v", got, nums)
+	}
+	tests := []struct {
+		k    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{7, []int{3, 4, 5, 1, 2}},
+	}
+	for _, tt := range tests {
+		if got := slice.Rotate(nums, tt.k); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Rotate(%d) = %v, want %v", tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	got := slice.Transpose([][]rune{[]rune("abc"), []rune("def")})
+	want := [][]rune{[]rune("ad"), []rune("be"), []rune("cf")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Transpose() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,89 @@
+// Package stack provides a generic LIFO stack
+package stack
+
+import "fmt"
+
+// Stack is a LIFO stack backed by a slice
+type Stack[T any] struct {
+	items []T
+}
+
+// New returns a stack with the given items pushed in order, so the last one
+// is on top
+func New[T any](items ...T) *Stack[T] {
+	return &Stack[T]{items: append([]T{}, items...)}
+}
+
+// Push puts the items on top of the stack in order, so the last one ends up
+// on top
+func (s *Stack[T]) Push(items ...T) {
+	s.items = append(s.items, items...)
+}
+
+// Pop removes and returns the top item, ok is false if the stack is empty
+func (s *Stack[T]) Pop() (item T, ok bool) {
+	if len(s.items) == 0 {
+		return item, false
+	}
+	item = s.items[len(s.items)-1]
+	var zero T
+	s.items[len(s.items)-1] = zero
+	s.items = s.items[:len(s.items)-1]
+	return item, true
+}
+
+// PopN removes the top n items and returns them in stack order, i.e. the
+// former top item last. It panics if there are less than n items
+func (s *Stack[T]) PopN(n int) []T {
+	if n < 0 || n > len(s.items) {
+		panic(fmt.Sprintf("cannot pop %d of %d items", n, len(s.items)))
+	}
+	rest := len(s.items) - n
+	popped := append([]T{}, s.items[rest:]...)
+	var zero T
+	for i := rest; i < len(s.items); i++ {
+		s.items[i] = zero
+	}
+	s.items = s.items[:rest]
+	return popped
+}
+
+// Peek returns the top item without removing it, ok is false if the stack
+// is empty
+func (s *Stack[T]) Peek() (item T, ok bool) {
+	if len(s.items) == 0 {
+		return item, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of items on the stack
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns a copy of all items from bottom to top
+func (s *Stack[T]) Items() []T {
+	return append([]T{}, s.items...)
+}
+
+// MoveOneByOne moves n items to dst one after another, which reverses their
+// order. It panics if there are less than n items
+func (s *Stack[T]) MoveOneByOne(dst *Stack[T], n int) {
+	popped := s.PopN(n)
+	for i := len(popped) - 1; i >= 0; i-- {
+		dst.Push(popped[i])
+	}
+}
+
+// MoveAtOnce moves n items to dst as a whole, which keeps their order. It
+// panics if there are less than n items
+func (s *Stack[T]) MoveAtOnce(dst *Stack[T], n int) {
+	dst.Push(s.PopN(n)...)
+}
+
+// String lists the items from bottom to top, so the top is last, e.g.
+// [Z N D] for D on top
+func (s *Stack[T]) String() string {
+	return fmt.Sprint(s.items)
+}
@@ -0,0 +1,52 @@
+package stack_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/stack"
+)
+
+func TestStack(t *testing.T) {
+	s := stack.New(1, 2)
+	s.Push(3, 4)
+	if top, ok := s.Peek(); !ok || top != 4 || s.Len() != 4 {
+		t.Errorf("Peek() = %v, %t, Len() = %d", top, ok, s.Len())
+	}
+	if top, ok := s.Pop(); !ok || top != 4 {
+		t.Errorf("Pop() = %v, %t", top, ok)
+	}
+	if got := s.PopN(2); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("PopN() = %v", got)
+	}
+	s.Pop()
+	if _, ok := s.Pop(); ok {
+		t.Errorf("Pop() on empty stack should not be ok")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Errorf("Peek() on empty stack should not be ok")
+	}
+}
+
+func TestStackMoves(t *testing.T) {
+	// move 3 from 1 to 3 in the crate stacking example
+	from, to := stack.New("Z", "N", "D"), stack.New("P")
+	from.MoveOneByOne(to, 3)
+	if got := to.Items(); !reflect.DeepEqual(got, []string{"P", "D", "N", "Z"}) {
+		t.Errorf("MoveOneByOne() = %v", got)
+	}
+	to.MoveAtOnce(from, 2)
+	if got := from.Items(); !reflect.DeepEqual(got, []string{"N", "Z"}) {
+		t.Errorf("MoveAtOnce() = %v", got)
+	}
+	if got := to.String(); got != "[P D]" {
+		t.Errorf("String() = %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("moving more items than the stack holds should panic")
+		}
+	}()
+	from.MoveAtOnce(to, 3)
+}
@@ -0,0 +1,129 @@
+package unionfind
+
+import "sort"
+
+var offsets4 = [][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+
+// Region is a connected area of orthogonally adjacent cells
+type Region struct {
+	// Start is the first cell of the region in row major order
+	Start [2]int
+	// Size is the number of cells
+	Size int
+	// Perimeter is the number of cell edges bordering cells outside of the
+	// region, including the ones at the edge of the grid
+	Perimeter int
+}
+
+// LabelGrid labels the connected regions of cells for which in returns true
+// Labels are indices into the returned regions, cells outside of all
+// regions are labeled -1. Rows may have different lengths
+func LabelGrid[T any](grid [][]T, in func(T) bool) ([][]int, []Region) {
+	inCell := func(row, col int) bool {
+		return row >= 0 && row < len(grid) && col >= 0 && col < len(grid[row]) && in(grid[row][col])
+	}
+
+	// every cell gets the id offsets[row] + col
+	offsets := make([]int, len(grid)+1)
+	for row, line := range grid {
+		offsets[row+1] = offsets[row] + len(line)
+	}
+	sets := NewIntSets(offsets[len(grid)])
+	for row, line := range grid {
+		for col := range line {
+			// joining with the cells above and left is enough to join all
+			if !inCell(row, col) {
+				continue
+			}
+			if inCell(row-1, col) {
+				sets.Union(offsets[row]+col, offsets[row-1]+col)
+			}
+			if inCell(row, col-1) {
+				sets.Union(offsets[row]+col, offsets[row]+col-1)
+			}
+		}
+	}
+
+	labels := make([][]int, len(grid))
+	var regions []Region
+	labelOf := map[int]int{}
+	for row, line := range grid {
+		labels[row] = make([]int, len(line))
+		for col := range line {
+			if !inCell(row, col) {
+				labels[row][col] = -1
+				continue
+			}
+			label, ok := labelOf[sets.Find(offsets[row]+col)]
+			if !ok {
+				label = len(regions)
+				labelOf[sets.Find(offsets[row]+col)] = label
+				regions = append(regions, Region{Start: [2]int{row, col}})
+			}
+			labels[row][col] = label
+			regions[label].Size++
+			for _, off := range offsets4 {
+				if !inCell(row+off[0], col+off[1]) {
+					regions[label].Perimeter++
+				}
+			}
+		}
+	}
+	return labels, regions
+}
+
+// LabelSparse labels the connected regions of set cells for which in
+// returns true, like LabelGrid. Cells outside of all regions have no label
+func LabelSparse[T any](cells map[[2]int]T, in func(T) bool) (map[[2]int]int, []Region) {
+	inCell := func(cell [2]int) bool {
+		val, ok := cells[cell]
+		return ok && in(val)
+	}
+
+	sets := New[[2]int]()
+	for cell := range cells {
+		if !inCell(cell) {
+			continue
+		}
+		sets.Add(cell)
+		for _, off := range offsets4[:2] {
+			if next := [2]int{cell[0] + off[0], cell[1] + off[1]}; inCell(next) {
+				sets.Union(cell, next)
+			}
+		}
+	}
+
+	// label in row major order, so the labels do not depend on map order
+	groups := sets.Groups()
+	var roots [][2]int
+	starts := map[[2]int][2]int{}
+	for root, members := range groups {
+		start := members[0]
+		for _, cell := range members[1:] {
+			if cell[0] < start[0] || cell[0] == start[0] && cell[1] < start[1] {
+				start = cell
+			}
+		}
+		starts[root] = start
+		roots = append(roots, root)
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		a, b := starts[roots[i]], starts[roots[j]]
+		return a[0] < b[0] || a[0] == b[0] && a[1] < b[1]
+	})
+
+	labels := make(map[[2]int]int, len(cells))
+	regions := make([]Region, len(roots))
+	for label, root := range roots {
+		regions[label] = Region{Start: starts[root], Size: len(groups[root])}
+		for _, cell := range groups[root] {
+			labels[cell] = label
+			for _, off := range offsets4 {
+				if !inCell([2]int{cell[0] + off[0], cell[1] + off[1]}) {
+					regions[label].Perimeter++
+				}
+			}
+		}
+	}
+	return labels, regions
+}
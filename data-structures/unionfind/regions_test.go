@@ -0,0 +1,63 @@
+package unionfind_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/unionfind"
+)
+
+var plots = `AAAA
+BBCD
+BBCC
+EEEC`
+
+func TestLabelGrid(t *testing.T) {
+	var grid [][]rune
+	for _, line := range strings.Split(plots, "\n") {
+		grid = append(grid, []rune(line))
+	}
+	labels, regions := unionfind.LabelGrid(grid, func(r rune) bool { return r != 'A' && r != 'C' })
+
+	wantLabels := [][]int{
+		{-1, -1, -1, -1},
+		{0, 0, -1, 1},
+		{0, 0, -1, -1},
+		{0, 0, 0, -1},
+	}
+	if !reflect.DeepEqual(labels, wantLabels) {
+		t.Errorf("LabelGrid() labels = %v, want %v", labels, wantLabels)
+	}
+	wantRegions := []unionfind.Region{
+		{Start: [2]int{1, 0}, Size: 7, Perimeter: 12},
+		{Start: [2]int{1, 3}, Size: 1, Perimeter: 4},
+	}
+	if !reflect.DeepEqual(regions, wantRegions) {
+		t.Errorf("LabelGrid() regions = %+v, want %+v", regions, wantRegions)
+	}
+}
+
+func TestLabelSparse(t *testing.T) {
+	cells := map[[2]int]bool{
+		{0, 0}: true, {0, 1}: true, {1, 1}: true,
+		{5, 5}: true, {5, 6}: false,
+		{-3, 2}: true,
+	}
+	labels, regions := unionfind.LabelSparse(cells, func(lava bool) bool { return lava })
+
+	wantRegions := []unionfind.Region{
+		{Start: [2]int{-3, 2}, Size: 1, Perimeter: 4},
+		{Start: [2]int{0, 0}, Size: 3, Perimeter: 8},
+		{Start: [2]int{5, 5}, Size: 1, Perimeter: 4},
+	}
+	if !reflect.DeepEqual(regions, wantRegions) {
+		t.Errorf("LabelSparse() regions = %+v, want %+v", regions, wantRegions)
+	}
+	if labels[[2]int{1, 1}] != 1 || len(labels) != 5 {
+		t.Errorf("LabelSparse() labels = %v", labels)
+	}
+	if _, ok := labels[[2]int{5, 6}]; ok {
+		t.Errorf("LabelSparse() labeled a cell outside of the predicate")
+	}
+}
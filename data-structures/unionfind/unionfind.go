@@ -0,0 +1,166 @@
+// Package unionfind provides disjoint sets with path compression and union
+// by rank, so Find and Union take nearly constant amortized time
+package unionfind
+
+// IntSets partitions the ints 0 to n-1 into disjoint sets
+type IntSets struct {
+	parent []int
+	rank   []uint8
+	size   []int
+	count  int
+}
+
+// NewIntSets returns n singleton sets, one for each of the ints 0 to n-1
+func NewIntSets(n int) *IntSets {
+	s := &IntSets{
+		parent: make([]int, n),
+		rank:   make([]uint8, n),
+		size:   make([]int, n),
+		count:  n,
+	}
+	for i := range s.parent {
+		s.parent[i] = i
+		s.size[i] = 1
+	}
+	return s
+}
+
+// Find returns the representative of the set containing x
+func (s *IntSets) Find(x int) int {
+	root := x
+	for s.parent[root] != root {
+		root = s.parent[root]
+	}
+	// path compression: point everything on the way directly to the root
+	for s.parent[x] != root {
+		s.parent[x], x = root, s.parent[x]
+	}
+	return root
+}
+
+// Union merges the sets containing a and b, it returns false if they were
+// already in the same set
+func (s *IntSets) Union(a, b int) bool {
+	rootA, rootB := s.Find(a), s.Find(b)
+	if rootA == rootB {
+		return false
+	}
+	if s.rank[rootA] < s.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	s.parent[rootB] = rootA
+	s.size[rootA] += s.size[rootB]
+	if s.rank[rootA] == s.rank[rootB] {
+		s.rank[rootA]++
+	}
+	s.count--
+	return true
+}
+
+// Connected reports whether a and b are in the same set
+func (s *IntSets) Connected(a, b int) bool {
+	return s.Find(a) == s.Find(b)
+}
+
+// Size returns the number of elements in the set containing x
+func (s *IntSets) Size(x int) int {
+	return s.size[s.Find(x)]
+}
+
+// Count returns the number of disjoint sets
+func (s *IntSets) Count() int {
+	return s.count
+}
+
+// Sets partitions comparable keys into disjoint sets, keys are added as
+// singleton sets by Add and Union. Lookups never add keys
+type Sets[K comparable] struct {
+	index map[K]int
+	keys  []K
+	sets  *IntSets
+}
+
+// New returns sets with the given keys added as singletons
+func New[K comparable](keys ...K) *Sets[K] {
+	s := &Sets[K]{index: map[K]int{}, sets: NewIntSets(0)}
+	for _, key := range keys {
+		s.Add(key)
+	}
+	return s
+}
+
+// Add adds key as a singleton set if it is not known yet
+func (s *Sets[K]) Add(key K) {
+	s.id(key)
+}
+
+// Has reports whether key was added
+func (s *Sets[K]) Has(key K) bool {
+	_, ok := s.index[key]
+	return ok
+}
+
+// Find returns the representative key of the set containing key, ok is false
+// if key was never added
+func (s *Sets[K]) Find(key K) (root K, ok bool) {
+	id, ok := s.index[key]
+	if !ok {
+		return root, false
+	}
+	return s.keys[s.sets.Find(id)], true
+}
+
+// Union merges the sets containing a and b, it returns false if they were
+// already in the same set
+func (s *Sets[K]) Union(a, b K) bool {
+	return s.sets.Union(s.id(a), s.id(b))
+}
+
+// Connected reports whether a and b are in the same set, which is never the
+// case if one of them was not added
+func (s *Sets[K]) Connected(a, b K) bool {
+	idA, okA := s.index[a]
+	idB, okB := s.index[b]
+	return okA && okB && s.sets.Connected(idA, idB)
+}
+
+// Size returns the number of keys in the set containing key, 0 if key was not
+// added
+func (s *Sets[K]) Size(key K) int {
+	id, ok := s.index[key]
+	if !ok {
+		return 0
+	}
+	return s.sets.Size(id)
+}
+
+// Count returns the number of disjoint sets
+func (s *Sets[K]) Count() int {
+	return s.sets.Count()
+}
+
+// Groups returns all sets by their representative key, the keys of every set
+// are in the order they were added
+func (s *Sets[K]) Groups() map[K][]K {
+	groups := map[K][]K{}
+	for id, key := range s.keys {
+		root := s.keys[s.sets.Find(id)]
+		groups[root] = append(groups[root], key)
+	}
+	return groups
+}
+
+// id returns the int of key in the underlying IntSets, adding it if needed
+func (s *Sets[K]) id(key K) int {
+	if id, ok := s.index[key]; ok {
+		return id
+	}
+	id := len(s.keys)
+	s.index[key] = id
+	s.keys = append(s.keys, key)
+	s.sets.parent = append(s.sets.parent, id)
+	s.sets.rank = append(s.sets.rank, 0)
+	s.sets.size = append(s.sets.size, 1)
+	s.sets.count++
+	return id
+}
@@ -0,0 +1,56 @@
+package unionfind_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/unionfind"
+)
+
+func TestIntSets(t *testing.T) {
+	sets := unionfind.NewIntSets(6)
+	if !sets.Union(0, 1) || !sets.Union(2, 3) || !sets.Union(1, 3) {
+		t.Errorf("Union() of disjoint sets should return true")
+	}
+	if sets.Union(0, 2) {
+		t.Errorf("Union() of the same set should return false")
+	}
+	if !sets.Connected(0, 3) || sets.Connected(0, 4) {
+		t.Errorf("Connected() does not match the unions")
+	}
+	if sets.Size(2) != 4 || sets.Size(5) != 1 || sets.Count() != 3 {
+		t.Errorf("Size() = %d, %d, Count() = %d", sets.Size(2), sets.Size(5), sets.Count())
+	}
+}
+
+func TestSets(t *testing.T) {
+	sets := unionfind.New("a", "b", "c")
+	sets.Union("a", "c")
+	sets.Union("d", "e")
+	if !sets.Has("d") || sets.Has("x") {
+		t.Errorf("Has() does not match the added keys")
+	}
+	root := func(key string) string {
+		root, ok := sets.Find(key)
+		if !ok {
+			t.Errorf("Find(%q) should find an added key", key)
+		}
+		return root
+	}
+	if root("c") != root("a") || sets.Connected("a", "b") || sets.Size("e") != 2 {
+		t.Errorf("Find()/Connected()/Size() do not match the unions")
+	}
+
+	// lookups of unknown keys must not add them
+	if _, ok := sets.Find("x"); ok || sets.Connected("x", "x") || sets.Size("y") != 0 || sets.Has("x") || sets.Has("y") {
+		t.Errorf("Find()/Connected()/Size() of unknown keys should report them as missing")
+	}
+	want := map[string][]string{
+		root("a"): {"a", "c"},
+		root("b"): {"b"},
+		root("d"): {"d", "e"},
+	}
+	if got := sets.Groups(); !reflect.DeepEqual(got, want) || sets.Count() != 3 {
+		t.Errorf("Groups() = %v, want %v", got, want)
+	}
+}
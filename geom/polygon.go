@@ -0,0 +1,94 @@
+package geom
+
+import "github.com/mheidinger/advent-of-code-go/mathy"
+
+// Polygon is a simple polygon given by its ordered lattice vertices, either
+// clockwise or counterclockwise. The closing edge from the last back to the
+// first vertex is implicit, repeating the first vertex at the end is allowed
+type Polygon []Vec2
+
+// WalkPolygon returns the polygon traced by starting at start and moving
+// lengths[i] steps into dirs[i], e.g. for a dig plan
+func WalkPolygon(start Vec2, dirs []Vec2, lengths []int) Polygon {
+	if len(dirs) != len(lengths) {
+		panic("every direction needs a length")
+	}
+	poly := make(Polygon, 0, len(dirs)+1)
+	pos := start
+	poly = append(poly, pos)
+	for i, dir := range dirs {
+		pos = pos.Add(dir.Scale(lengths[i]))
+		poly = append(poly, pos)
+	}
+	return poly
+}
+
+// edges calls fn for every edge, including the closing one
+func (p Polygon) edges(fn func(from, to Vec2)) {
+	for i := range p {
+		fn(p[i], p[(i+1)%len(p)])
+	}
+}
+
+// DoubleArea returns twice the enclosed area via the shoelace formula, which
+// is always an integer for lattice polygons
+func (p Polygon) DoubleArea() int64 {
+	var sum int64
+	p.edges(func(from, to Vec2) {
+		sum += int64(from.X)*int64(to.Y) - int64(to.X)*int64(from.Y)
+	})
+	return mathy.Abs(sum)
+}
+
+// Area returns the enclosed area, rounded down if it is not an integer
+func (p Polygon) Area() int64 {
+	return p.DoubleArea() / 2
+}
+
+// BoundaryPoints returns the number of lattice points on the edges, an edge
+// from a to b contains gcd(|dx|, |dy|) of them (excluding one end)
+// Degenerate polygons with fewer than 3 vertices or without area run back
+// over their own edges, there every point on the path is counted once
+func (p Polygon) BoundaryPoints() int64 {
+	if len(p) < 3 || p.DoubleArea() == 0 {
+		return p.pathPoints()
+	}
+	var count int64
+	p.edges(func(from, to Vec2) {
+		diff := to.Sub(from)
+		count += int64(mathy.GCD(diff.X, diff.Y))
+	})
+	return count
+}
+
+// pathPoints returns the number of distinct lattice points on the edges
+func (p Polygon) pathPoints() int64 {
+	points := map[Vec2]bool{}
+	p.edges(func(from, to Vec2) {
+		diff := to.Sub(from)
+		steps := mathy.GCD(diff.X, diff.Y)
+		points[from] = true
+		for i := 1; i <= steps; i++ {
+			points[from.Add(Vec2{X: diff.X / steps * i, Y: diff.Y / steps * i})] = true
+		}
+	})
+	return int64(len(points))
+}
+
+// InteriorPoints returns the number of lattice points strictly inside the
+// polygon via Pick's theorem A = I + B/2 - 1. Degenerate polygons with fewer
+// than 3 vertices or without area have none
+func (p Polygon) InteriorPoints() int64 {
+	area2 := p.DoubleArea()
+	if len(p) < 3 || area2 == 0 {
+		return 0
+	}
+	return (area2-p.BoundaryPoints())/2 + 1
+}
+
+// LatticePoints returns the number of lattice points inside or on the
+// polygon, i.e. the number of grid cells covered when the vertices are the
+// centers of cells (like a trench dug along the boundary)
+func (p Polygon) LatticePoints() int64 {
+	return p.InteriorPoints() + p.BoundaryPoints()
+}
@@ -0,0 +1,103 @@
+package geom_test
+
+import (
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/geom"
+)
+
+func TestPolygon(t *testing.T) {
+	tests := []struct {
+		name         string
+		poly         geom.Polygon
+		wantArea2    int64
+		wantBoundary int64
+		wantInterior int64
+	}{
+		{
+			name:         "square",
+			poly:         geom.Polygon{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 4}, {X: 0, Y: 4}},
+			wantArea2:    32,
+			wantBoundary: 16,
+			wantInterior: 9,
+		},
+		{
+			name:         "closed counterclockwise",
+			poly:         geom.Polygon{{X: 0, Y: 0}, {X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 0}, {X: 0, Y: 0}},
+			wantArea2:    32,
+			wantBoundary: 16,
+			wantInterior: 9,
+		},
+		{
+			name:         "triangle with diagonal",
+			poly:         geom.Polygon{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: 3}},
+			wantArea2:    9,
+			wantBoundary: 9,
+			wantInterior: 1,
+		},
+		{
+			name: "empty",
+			poly: geom.Polygon{},
+		},
+		{
+			name:         "single point",
+			poly:         geom.Polygon{{X: 2, Y: 3}},
+			wantBoundary: 1,
+		},
+		{
+			name:         "segment",
+			poly:         geom.Polygon{{X: 0, Y: 0}, {X: 4, Y: 0}},
+			wantBoundary: 5,
+		},
+		{
+			name:         "diagonal segment",
+			poly:         geom.Polygon{{X: 0, Y: 0}, {X: 2, Y: -4}},
+			wantBoundary: 3,
+		},
+		{
+			name:         "collinear",
+			poly:         geom.Polygon{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 4, Y: 0}},
+			wantBoundary: 5,
+		},
+		{
+			name:         "closed segment",
+			poly:         geom.Polygon{{X: 0, Y: 0}, {X: 0, Y: 3}, {X: 0, Y: 0}},
+			wantBoundary: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.poly.DoubleArea(); got != tt.wantArea2 {
+				t.Errorf("DoubleArea() = %d, want %d", got, tt.wantArea2)
+			}
+			if got := tt.poly.BoundaryPoints(); got != tt.wantBoundary {
+				t.Errorf("BoundaryPoints() = %d, want %d", got, tt.wantBoundary)
+			}
+			if got := tt.poly.InteriorPoints(); got != tt.wantInterior {
+				t.Errorf("InteriorPoints() = %d, want %d", got, tt.wantInterior)
+			}
+			if got, want := tt.poly.LatticePoints(), tt.wantBoundary+tt.wantInterior; got != want {
+				t.Errorf("LatticePoints() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestWalkPolygon(t *testing.T) {
+	// dig plan example: the trench and its interior cover 62 cells
+	dirs := []geom.Vec2{geom.R, geom.D, geom.L, geom.D, geom.R, geom.D, geom.L, geom.U, geom.L, geom.U, geom.R, geom.U, geom.L, geom.U}
+	lengths := []int{6, 5, 2, 2, 2, 2, 5, 2, 1, 2, 2, 3, 2, 2}
+	poly := geom.WalkPolygon(geom.Vec2{}, dirs, lengths)
+	if got := poly.LatticePoints(); got != 62 {
+		t.Errorf("LatticePoints() = %d, want 62", got)
+	}
+	if got := poly.Area(); got != 42 {
+		t.Errorf("Area() = %d, want 42", got)
+	}
+
+	// coordinates that overflow an int32 when multiplied
+	big := geom.WalkPolygon(geom.Vec2{}, []geom.Vec2{geom.R, geom.D, geom.L, geom.U}, []int{1 << 20, 1 << 20, 1 << 20, 1 << 20})
+	if got := big.Area(); got != 1<<40 {
+		t.Errorf("Area() = %d, want %d", got, int64(1)<<40)
+	}
+}
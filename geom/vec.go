@@ -0,0 +1,251 @@
+// Package geom contains small 2D and 3D integer vector types for all the
+// puzzles that move things around on a grid or in space
+package geom
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mheidinger/advent-of-code-go/mathy"
+)
+
+// Vec2 is a 2D integer vector or point. Directions assume screen coordinates,
+// i.e. x grows to the right and y grows downwards
+type Vec2 struct {
+	X, Y int
+}
+
+// Direction vectors in screen coordinates, N/U is up (negative y)
+var (
+	N = Vec2{0, -1}
+	E = Vec2{1, 0}
+	S = Vec2{0, 1}
+	W = Vec2{-1, 0}
+
+	U = N
+	R = E
+	D = S
+	L = W
+
+	// Dirs4 are the 4 orthogonal directions, clockwise starting at N
+	Dirs4 = []Vec2{N, E, S, W}
+	// Dirs8 are the 8 directions including diagonals, clockwise starting at N
+	Dirs8 = []Vec2{N, {1, -1}, E, {1, 1}, S, {-1, 1}, W, {-1, -1}}
+)
+
+// Add returns the component-wise sum
+func (v Vec2) Add(o Vec2) Vec2 {
+	return Vec2{v.X + o.X, v.Y + o.Y}
+}
+
+// Sub returns the component-wise difference
+func (v Vec2) Sub(o Vec2) Vec2 {
+	return Vec2{v.X - o.X, v.Y - o.Y}
+}
+
+// Scale multiplies both components by k
+func (v Vec2) Scale(k int) Vec2 {
+	return Vec2{v.X * k, v.Y * k}
+}
+
+// Abs returns the vector with both components made positive
+func (v Vec2) Abs() Vec2 {
+	return Vec2{mathy.AbsInt(v.X), mathy.AbsInt(v.Y)}
+}
+
+// Sign returns the vector with every component reduced to -1, 0 or 1, i.e.
+// a single step "towards" v
+func (v Vec2) Sign() Vec2 {
+	return Vec2{mathy.Sign(v.X), mathy.Sign(v.Y)}
+}
+
+// Manhattan returns the taxicab distance between v and o
+func (v Vec2) Manhattan(o Vec2) int {
+	d := v.Sub(o).Abs()
+	return d.X + d.Y
+}
+
+// Chebyshev returns the chessboard distance between v and o, i.e. diagonal
+// steps count as one
+func (v Vec2) Chebyshev(o Vec2) int {
+	d := v.Sub(o).Abs()
+	return mathy.MaxInt(d.X, d.Y)
+}
+
+// Euclidean returns the straight line distance between v and o
+func (v Vec2) Euclidean(o Vec2) float64 {
+	d := v.Sub(o)
+	return math.Sqrt(float64(d.X*d.X + d.Y*d.Y))
+}
+
+// RotateCW rotates v by 90 degrees clockwise around the origin, e.g. N -> E
+func (v Vec2) RotateCW() Vec2 {
+	return Vec2{-v.Y, v.X}
+}
+
+// RotateCCW rotates v by 90 degrees counterclockwise around the origin,
+// e.g. N -> W
+func (v Vec2) RotateCCW() Vec2 {
+	return Vec2{v.Y, -v.X}
+}
+
+// Neighbors4 returns the 4 orthogonal neighbors of v, in the order of Dirs4
+func (v Vec2) Neighbors4() []Vec2 {
+	return v.neighbors(Dirs4)
+}
+
+// Neighbors8 returns the 8 neighbors of v, in the order of Dirs8
+func (v Vec2) Neighbors8() []Vec2 {
+	return v.neighbors(Dirs8)
+}
+
+func (v Vec2) neighbors(dirs []Vec2) []Vec2 {
+	neighbors := make([]Vec2, 0, len(dirs))
+	for _, dir := range dirs {
+		neighbors = append(neighbors, v.Add(dir))
+	}
+	return neighbors
+}
+
+// String formats the vector as "x,y", the same format ParseVec2 reads
+func (v Vec2) String() string {
+	return fmt.Sprintf("%d,%d", v.X, v.Y)
+}
+
+// ParseVec2 parses "x,y" into a Vec2, whitespace around the numbers is ignored
+func ParseVec2(s string) (Vec2, error) {
+	nums, err := parseInts(s, 2)
+	if err != nil {
+		return Vec2{}, err
+	}
+	return Vec2{nums[0], nums[1]}, nil
+}
+
+// MustParseVec2 is ParseVec2 but panics on invalid input
+func MustParseVec2(s string) Vec2 {
+	v, err := ParseVec2(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Vec3 is a 3D integer vector or point
+type Vec3 struct {
+	X, Y, Z int
+}
+
+// Dirs6 are the 6 directions to the face-adjacent neighbors of a cube
+var Dirs6 = []Vec3{
+	{1, 0, 0}, {-1, 0, 0},
+	{0, 1, 0}, {0, -1, 0},
+	{0, 0, 1}, {0, 0, -1},
+}
+
+// Add returns the component-wise sum
+func (v Vec3) Add(o Vec3) Vec3 {
+	return Vec3{v.X + o.X, v.Y + o.Y, v.Z + o.Z}
+}
+
+// Sub returns the component-wise difference
+func (v Vec3) Sub(o Vec3) Vec3 {
+	return Vec3{v.X - o.X, v.Y - o.Y, v.Z - o.Z}
+}
+
+// Scale multiplies all components by k
+func (v Vec3) Scale(k int) Vec3 {
+	return Vec3{v.X * k, v.Y * k, v.Z * k}
+}
+
+// Abs returns the vector with all components made positive
+func (v Vec3) Abs() Vec3 {
+	return Vec3{mathy.AbsInt(v.X), mathy.AbsInt(v.Y), mathy.AbsInt(v.Z)}
+}
+
+// Sign returns the vector with every component reduced to -1, 0 or 1
+func (v Vec3) Sign() Vec3 {
+	return Vec3{mathy.Sign(v.X), mathy.Sign(v.Y), mathy.Sign(v.Z)}
+}
+
+// Manhattan returns the taxicab distance between v and o
+func (v Vec3) Manhattan(o Vec3) int {
+	d := v.Sub(o).Abs()
+	return d.X + d.Y + d.Z
+}
+
+// Chebyshev returns the largest distance along any axis between v and o
+func (v Vec3) Chebyshev(o Vec3) int {
+	d := v.Sub(o).Abs()
+	return mathy.MaxInt(d.X, d.Y, d.Z)
+}
+
+// Euclidean returns the straight line distance between v and o
+func (v Vec3) Euclidean(o Vec3) float64 {
+	d := v.Sub(o)
+	return math.Sqrt(float64(d.X*d.X + d.Y*d.Y + d.Z*d.Z))
+}
+
+// RotateX rotates v by 90 degrees around the x axis (right-handed, y -> z)
+func (v Vec3) RotateX() Vec3 {
+	return Vec3{v.X, -v.Z, v.Y}
+}
+
+// RotateY rotates v by 90 degrees around the y axis (right-handed, z -> x)
+func (v Vec3) RotateY() Vec3 {
+	return Vec3{v.Z, v.Y, -v.X}
+}
+
+// RotateZ rotates v by 90 degrees around the z axis (right-handed, x -> y)
+func (v Vec3) RotateZ() Vec3 {
+	return Vec3{-v.Y, v.X, v.Z}
+}
+
+// Neighbors6 returns the 6 face-adjacent neighbors of v, in the order of Dirs6
+func (v Vec3) Neighbors6() []Vec3 {
+	neighbors := make([]Vec3, 0, len(Dirs6))
+	for _, dir := range Dirs6 {
+		neighbors = append(neighbors, v.Add(dir))
+	}
+	return neighbors
+}
+
+// String formats the vector as "x,y,z", the same format ParseVec3 reads
+func (v Vec3) String() string {
+	return fmt.Sprintf("%d,%d,%d", v.X, v.Y, v.Z)
+}
+
+// ParseVec3 parses "x,y,z" into a Vec3, whitespace around the numbers is ignored
+func ParseVec3(s string) (Vec3, error) {
+	nums, err := parseInts(s, 3)
+	if err != nil {
+		return Vec3{}, err
+	}
+	return Vec3{nums[0], nums[1], nums[2]}, nil
+}
+
+// MustParseVec3 is ParseVec3 but panics on invalid input
+func MustParseVec3(s string) Vec3 {
+	v, err := ParseVec3(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func parseInts(s string, n int) ([]int, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != n {
+		return nil, fmt.Errorf("parsing %q: want %d comma separated values, got %d", s, n, len(parts))
+	}
+	nums := make([]int, 0, n)
+	for _, part := range parts {
+		num, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %w", s, err)
+		}
+		nums = append(nums, num)
+	}
+	return nums, nil
+}
@@ -0,0 +1,105 @@
+package geom_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/geom"
+)
+
+func TestVec2Distances(t *testing.T) {
+	tests := []struct {
+		a, b          geom.Vec2
+		wantManhattan int
+		wantChebyshev int
+		wantEuclidean float64
+	}{
+		{geom.Vec2{0, 0}, geom.Vec2{3, 4}, 7, 4, 5},
+		{geom.Vec2{-1, -1}, geom.Vec2{1, 1}, 4, 2, math.Sqrt(8)},
+		{geom.Vec2{2, 5}, geom.Vec2{2, 5}, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Manhattan(tt.b); got != tt.wantManhattan {
+			t.Errorf("%v.Manhattan(%v) = %d, want %d", tt.a, tt.b, got, tt.wantManhattan)
+		}
+		if got := tt.a.Chebyshev(tt.b); got != tt.wantChebyshev {
+			t.Errorf("%v.Chebyshev(%v) = %d, want %d", tt.a, tt.b, got, tt.wantChebyshev)
+		}
+		if got := tt.a.Euclidean(tt.b); got != tt.wantEuclidean {
+			t.Errorf("%v.Euclidean(%v) = %f, want %f", tt.a, tt.b, got, tt.wantEuclidean)
+		}
+	}
+}
+
+func TestVec2Arithmetic(t *testing.T) {
+	v := geom.Vec2{3, -2}
+	if got, want := v.Add(geom.Vec2{1, 1}), (geom.Vec2{4, -1}); got != want {
+		t.Errorf("Add() = %v, want %v", got, want)
+	}
+	if got, want := v.Sub(geom.Vec2{1, 1}), (geom.Vec2{2, -3}); got != want {
+		t.Errorf("Sub() = %v, want %v", got, want)
+	}
+	if got, want := v.Scale(-2), (geom.Vec2{-6, 4}); got != want {
+		t.Errorf("Scale() = %v, want %v", got, want)
+	}
+	if got, want := v.Sign(), (geom.Vec2{1, -1}); got != want {
+		t.Errorf("Sign() = %v, want %v", got, want)
+	}
+	if got, want := (geom.Vec2{0, 7}).Sign(), (geom.Vec2{0, 1}); got != want {
+		t.Errorf("Sign() = %v, want %v", got, want)
+	}
+}
+
+func TestVec2Rotate(t *testing.T) {
+	dir := geom.N
+	for _, want := range []geom.Vec2{geom.E, geom.S, geom.W, geom.N} {
+		dir = dir.RotateCW()
+		if dir != want {
+			t.Errorf("RotateCW() = %v, want %v", dir, want)
+		}
+		if back := dir.RotateCCW(); back.RotateCW() != dir {
+			t.Errorf("RotateCCW() is not the inverse of RotateCW() for %v", dir)
+		}
+	}
+	if geom.U != geom.N || geom.R != geom.E || geom.D != geom.S || geom.L != geom.W {
+		t.Errorf("U/R/D/L are not aliases of N/E/S/W")
+	}
+}
+
+func TestVec3Rotate(t *testing.T) {
+	v := geom.Vec3{1, 2, 3}
+	rotations := map[string]func(geom.Vec3) geom.Vec3{
+		"x": geom.Vec3.RotateX,
+		"y": geom.Vec3.RotateY,
+		"z": geom.Vec3.RotateZ,
+	}
+	for axis, rotate := range rotations {
+		got := rotate(rotate(rotate(rotate(v))))
+		if got != v {
+			t.Errorf("rotating 4 times around %s = %v, want %v", axis, got, v)
+		}
+	}
+	if got, want := (geom.Vec3{1, 0, 0}).RotateZ(), (geom.Vec3{0, 1, 0}); got != want {
+		t.Errorf("RotateZ() = %v, want %v", got, want)
+	}
+	if got, want := v.Manhattan(geom.Vec3{0, 0, 0}), 6; got != want {
+		t.Errorf("Manhattan() = %d, want %d", got, want)
+	}
+	if got, want := v.Chebyshev(geom.Vec3{0, 0, 0}), 3; got != want {
+		t.Errorf("Chebyshev() = %d, want %d", got, want)
+	}
+}
+
+func TestParse(t *testing.T) {
+	if got, err := geom.ParseVec2("498, -4"); err != nil || got != (geom.Vec2{498, -4}) {
+		t.Errorf("ParseVec2() = %v, %v, want 498,-4", got, err)
+	}
+	if got, err := geom.ParseVec3("2,2,5"); err != nil || got != (geom.Vec3{2, 2, 5}) {
+		t.Errorf("ParseVec3() = %v, %v, want 2,2,5", got, err)
+	}
+	for _, invalid := range []string{"1", "1,2,3", "a,b", ""} {
+		if _, err := geom.ParseVec2(invalid); err == nil {
+			t.Errorf("ParseVec2(%q) expected error", invalid)
+		}
+	}
+}
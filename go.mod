@@ -2,11 +2,9 @@ module github.com/mheidinger/advent-of-code-go
 
 go 1.18
 
-require golang.org/x/net v0.1.0
-
 require (
-	github.com/barkimedes/go-deepcopy v0.0.0-20220514131651-17c30cfc62df // indirect
-	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/schwarmco/go-cartesian-product v0.0.0-20180515110546-d5ee747a6dc9 // indirect
-	golang.org/x/exp v0.0.0-20221215174704-0915cd710c24 // indirect
+	golang.org/x/exp v0.0.0-20221215174704-0915cd710c24
+	golang.org/x/net v0.1.0
 )
+
+require github.com/barkimedes/go-deepcopy v0.0.0-20220514131651-17c30cfc62df
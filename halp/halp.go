@@ -1,3 +1,3 @@
 // Package halp is a bunch of helpers for AOC specific debugging like printing
-// infinite grids (map[[2]int]<T> <- Go generics will be useful here...)
+// infinite grids (map[[2]int]<T>, see grid.SparseGrid for the generic version)
 package halp
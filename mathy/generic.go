@@ -0,0 +1,114 @@
+package mathy
+
+import "golang.org/x/exp/constraints"
+
+// Number is any integer or float type
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// SignedNumber is any number type that can be negative
+type SignedNumber interface {
+	constraints.Signed | constraints.Float
+}
+
+// Max returns the largest value, ok is false if nums is empty
+func Max[T constraints.Ordered](nums ...T) (max T, ok bool) {
+	if len(nums) == 0 {
+		return max, false
+	}
+	max = nums[0]
+	for _, v := range nums[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return max, true
+}
+
+// Min returns the smallest value, ok is false if nums is empty
+func Min[T constraints.Ordered](nums ...T) (min T, ok bool) {
+	if len(nums) == 0 {
+		return min, false
+	}
+	min = nums[0]
+	for _, v := range nums[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min, true
+}
+
+// ArgMax returns the index of the item with the largest key, the first one
+// for ties. ok is false if items is empty
+func ArgMax[E any, K constraints.Ordered](items []E, key func(E) K) (index int, ok bool) {
+	return argBest(items, key, func(a, b K) bool { return a > b })
+}
+
+// ArgMin returns the index of the item with the smallest key, the first one
+// for ties. ok is false if items is empty
+func ArgMin[E any, K constraints.Ordered](items []E, key func(E) K) (index int, ok bool) {
+	return argBest(items, key, func(a, b K) bool { return a < b })
+}
+
+func argBest[E any, K constraints.Ordered](items []E, key func(E) K, better func(a, b K) bool) (int, bool) {
+	if len(items) == 0 {
+		return -1, false
+	}
+	bestIndex, bestKey := 0, key(items[0])
+	for i := 1; i < len(items); i++ {
+		if k := key(items[i]); better(k, bestKey) {
+			bestIndex, bestKey = i, k
+		}
+	}
+	return bestIndex, true
+}
+
+// Abs returns the absolute value of x
+func Abs[T SignedNumber](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of x
+func Sign[T SignedNumber](x T) T {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	}
+	return 0
+}
+
+// Clamp limits x to the range [lo, hi]
+func Clamp[T constraints.Ordered](x, lo, hi T) T {
+	if x < lo {
+		return lo
+	}
+	if x > hi {
+		return hi
+	}
+	return x
+}
+
+// Sum adds up all nums, 0 for an empty slice
+func Sum[T Number](nums ...T) T {
+	var sum T
+	for _, n := range nums {
+		sum += n
+	}
+	return sum
+}
+
+// Product multiplies all nums, 1 for an empty slice
+func Product[T Number](nums ...T) T {
+	product := T(1)
+	for _, n := range nums {
+		product *= n
+	}
+	return product
+}
@@ -0,0 +1,84 @@
+package mathy
+
+import "testing"
+
+func TestMaxMin(t *testing.T) {
+	if got, ok := Max(3, -1, 7, 2); !ok || got != 7 {
+		t.Errorf("Max() = %v, %v, want 7, true", got, ok)
+	}
+	if got, ok := Min(3.5, -1.25, 7); !ok || got != -1.25 {
+		t.Errorf("Min() = %v, %v, want -1.25, true", got, ok)
+	}
+	if got, ok := Max("b", "c", "a"); !ok || got != "c" {
+		t.Errorf("Max() = %v, %v, want c, true", got, ok)
+	}
+	if _, ok := Max[int](); ok {
+		t.Errorf("Max() of nothing ok = true, want false")
+	}
+	if _, ok := Min[uint8](); ok {
+		t.Errorf("Min() of nothing ok = true, want false")
+	}
+	// the old int helpers must not panic on empty input anymore
+	if got := MaxInt(); got != 0 {
+		t.Errorf("MaxInt() = %d, want 0", got)
+	}
+}
+
+func TestArgMaxMin(t *testing.T) {
+	words := []string{"go", "advent", "of", "code", "kringle"}
+	length := func(s string) int { return len(s) }
+	if got, ok := ArgMax(words, length); !ok || got != 4 {
+		t.Errorf("ArgMax() = %d, %v, want 4, true", got, ok)
+	}
+	// first one wins ties
+	if got, ok := ArgMin(words, length); !ok || got != 0 {
+		t.Errorf("ArgMin() = %d, %v, want 0, true", got, ok)
+	}
+	if got, ok := ArgMax([]string{}, length); ok || got != -1 {
+		t.Errorf("ArgMax() of nothing = %d, %v, want -1, false", got, ok)
+	}
+}
+
+func TestAbsSignClamp(t *testing.T) {
+	if got := Abs(-5); got != 5 {
+		t.Errorf("Abs(-5) = %d, want 5", got)
+	}
+	if got := Abs(-2.5); got != 2.5 {
+		t.Errorf("Abs(-2.5) = %v, want 2.5", got)
+	}
+	if got := Abs(int64(3)); got != 3 {
+		t.Errorf("Abs(3) = %d, want 3", got)
+	}
+	for x, want := range map[int]int{-7: -1, 0: 0, 42: 1} {
+		if got := Sign(x); got != want {
+			t.Errorf("Sign(%d) = %d, want %d", x, got, want)
+		}
+	}
+	if got := Clamp(15, 0, 10); got != 10 {
+		t.Errorf("Clamp(15, 0, 10) = %d, want 10", got)
+	}
+	if got := Clamp(-0.5, 0, 1); got != 0 {
+		t.Errorf("Clamp(-0.5, 0, 1) = %v, want 0", got)
+	}
+	if got := Clamp(5, 0, 10); got != 5 {
+		t.Errorf("Clamp(5, 0, 10) = %d, want 5", got)
+	}
+}
+
+func TestSumProduct(t *testing.T) {
+	if got := Sum(1, 2, 3, 4); got != 10 {
+		t.Errorf("Sum() = %d, want 10", got)
+	}
+	if got := Sum[float64](); got != 0 {
+		t.Errorf("Sum() of nothing = %v, want 0", got)
+	}
+	if got := Product(uint64(2), 3, 7); got != 42 {
+		t.Errorf("Product() = %d, want 42", got)
+	}
+	if got := Product[int](); got != 1 {
+		t.Errorf("Product() of nothing = %d, want 1", got)
+	}
+	if got := MultiplyIntSlice([]int{2, 5}); got != 10 {
+		t.Errorf("MultiplyIntSlice() = %d, want 10", got)
+	}
+}
@@ -0,0 +1,213 @@
+package mathy
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInfiniteSolutions is returned if a linear system is underdetermined
+var ErrInfiniteSolutions = errors.New("infinitely many solutions")
+
+// SolveLinear solves the system of linear equations a * x = b exactly with
+// Gaussian elimination. a has to be square, the inputs are not modified
+// Returns ErrNoSolution for inconsistent and ErrInfiniteSolutions for
+// underdetermined systems
+func SolveLinear(a [][]Rat, b []Rat) ([]Rat, error) {
+	n := len(a)
+	if len(b) != n {
+		return nil, fmt.Errorf("got %d equations but %d results", n, len(b))
+	}
+
+	// augmented matrix [a | b]
+	m := make([][]Rat, n)
+	for i := range a {
+		if len(a[i]) != n {
+			return nil, fmt.Errorf("row %d has %d coefficients, want %d", i, len(a[i]), n)
+		}
+		m[i] = append(append(make([]Rat, 0, n+1), a[i]...), b[i])
+	}
+
+	singular := false
+	for col, row := 0, 0; col < n; col++ {
+		pivot := -1
+		for r := row; r < n; r++ {
+			if !m[r][col].IsZero() {
+				pivot = r
+				break
+			}
+		}
+		if pivot == -1 {
+			singular = true
+			continue
+		}
+		m[row], m[pivot] = m[pivot], m[row]
+
+		// eliminate the column from all other rows (Gauss-Jordan)
+		for r := 0; r < n; r++ {
+			if r == row || m[r][col].IsZero() {
+				continue
+			}
+			factor := m[r][col].Quo(m[row][col])
+			for c := col; c <= n; c++ {
+				m[r][c] = m[r][c].Sub(factor.Mul(m[row][c]))
+			}
+		}
+		row++
+	}
+
+	if singular {
+		// a row of only zero coefficients with a non zero result is 0 = x
+		for _, r := range m {
+			allZero := true
+			for _, coeff := range r[:n] {
+				if !coeff.IsZero() {
+					allZero = false
+					break
+				}
+			}
+			if allZero && !r[n].IsZero() {
+				return nil, ErrNoSolution
+			}
+		}
+		return nil, ErrInfiniteSolutions
+	}
+
+	x := make([]Rat, n)
+	for i := range x {
+		x[i] = m[i][n].Quo(m[i][i])
+	}
+	return x, nil
+}
+
+// SolveLinearInts is SolveLinear for integer coefficients
+func SolveLinearInts(a [][]int, b []int) ([]Rat, error) {
+	ratA := make([][]Rat, len(a))
+	for i, row := range a {
+		for _, coeff := range row {
+			ratA[i] = append(ratA[i], IntRat(coeff))
+		}
+	}
+	ratB := make([]Rat, 0, len(b))
+	for _, v := range b {
+		ratB = append(ratB, IntRat(v))
+	}
+	return SolveLinear(ratA, ratB)
+}
+
+// Linear is the expression A*x + B in a single unknown x
+type Linear struct {
+	A, B Rat
+}
+
+// Constant returns the linear expression c
+func Constant(c Rat) Linear {
+	return Linear{B: c}
+}
+
+// Unknown returns the linear expression x
+func Unknown() Linear {
+	return Linear{A: IntRat(1)}
+}
+
+// IsConstant returns true if the expression does not depend on x
+func (l Linear) IsConstant() bool {
+	return l.A.IsZero()
+}
+
+// Add returns l + o
+func (l Linear) Add(o Linear) Linear {
+	return Linear{l.A.Add(o.A), l.B.Add(o.B)}
+}
+
+// Sub returns l - o
+func (l Linear) Sub(o Linear) Linear {
+	return Linear{l.A.Sub(o.A), l.B.Sub(o.B)}
+}
+
+// Mul returns l * o, which is only linear if one of them is constant
+func (l Linear) Mul(o Linear) (Linear, error) {
+	switch {
+	case o.IsConstant():
+		return Linear{l.A.Mul(o.B), l.B.Mul(o.B)}, nil
+	case l.IsConstant():
+		return Linear{o.A.Mul(l.B), o.B.Mul(l.B)}, nil
+	}
+	return Linear{}, fmt.Errorf("(%v) * (%v) is not linear", l, o)
+}
+
+// Quo returns l / o, which is only linear if o is a non zero constant
+func (l Linear) Quo(o Linear) (Linear, error) {
+	if !o.IsConstant() {
+		return Linear{}, fmt.Errorf("(%v) / (%v) is not linear", l, o)
+	}
+	if o.B.IsZero() {
+		return Linear{}, fmt.Errorf("(%v) / 0: division by zero", l)
+	}
+	return Linear{l.A.Quo(o.B), l.B.Quo(o.B)}, nil
+}
+
+// Apply combines l and o with one of the operators + - * /
+func (l Linear) Apply(op string, o Linear) (Linear, error) {
+	switch op {
+	case "+":
+		return l.Add(o), nil
+	case "-":
+		return l.Sub(o), nil
+	case "*":
+		return l.Mul(o)
+	case "/":
+		return l.Quo(o)
+	}
+	return Linear{}, fmt.Errorf("unknown operator %q", op)
+}
+
+// Eval returns the value of the expression for the given x
+func (l Linear) Eval(x Rat) Rat {
+	return l.A.Mul(x).Add(l.B)
+}
+
+// SolveEqual returns the x for which l == o, ErrNoSolution or
+// ErrInfiniteSolutions if both sides have the same slope
+func (l Linear) SolveEqual(o Linear) (Rat, error) {
+	diff := l.Sub(o)
+	if diff.IsConstant() {
+		if diff.B.IsZero() {
+			return Rat{}, ErrInfiniteSolutions
+		}
+		return Rat{}, ErrNoSolution
+	}
+	return diff.B.Neg().Quo(diff.A), nil
+}
+
+func (l Linear) String() string {
+	return fmt.Sprintf("%v*x + %v", l.A, l.B)
+}
+
+// ExprNode is a node of an expression tree. Leafs are either the unknown or
+// a constant Value, inner nodes combine Left and Right with Op (+ - * /)
+type ExprNode struct {
+	Op          string
+	Left, Right *ExprNode
+	Value       Rat
+	Unknown     bool
+}
+
+// Linearize reduces the expression tree into a single A*x + B, errors if the
+// tree is not linear in the unknown (e.g. x*x or 1/x)
+func Linearize(node *ExprNode) (Linear, error) {
+	if node.Unknown {
+		return Unknown(), nil
+	}
+	if node.Op == "" {
+		return Constant(node.Value), nil
+	}
+	left, err := Linearize(node.Left)
+	if err != nil {
+		return Linear{}, err
+	}
+	right, err := Linearize(node.Right)
+	if err != nil {
+		return Linear{}, err
+	}
+	return left.Apply(node.Op, right)
+}
@@ -0,0 +1,136 @@
+package mathy
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRat(t *testing.T) {
+	var zero Rat
+	if !zero.IsZero() || zero.String() != "0" {
+		t.Errorf("zero value = %v, want 0", zero)
+	}
+
+	third := NewRat(1, 3)
+	if got := third.Add(third).Add(third); !got.Equal(IntRat(1)) {
+		t.Errorf("1/3 + 1/3 + 1/3 = %v, want 1", got)
+	}
+	if got := NewRat(3, 4).Sub(NewRat(1, 2)); got.String() != "1/4" {
+		t.Errorf("3/4 - 1/2 = %v, want 1/4", got)
+	}
+	if got := NewRat(2, 3).Mul(NewRat(9, 4)).Quo(IntRat(3)); got.String() != "1/2" {
+		t.Errorf("2/3 * 9/4 / 3 = %v, want 1/2", got)
+	}
+	if got := NewRat(-6, 4); got.Sign() != -1 || got.Neg().String() != "3/2" {
+		t.Errorf("-6/4 = %v, want -3/2", got)
+	}
+	if n, ok := NewRat(10, 2).Int(); !ok || n != 5 {
+		t.Errorf("(10/2).Int() = %d, %t, want 5", n, ok)
+	}
+	if _, ok := NewRat(1, 2).Int(); ok {
+		t.Errorf("(1/2).Int() ok = true, want false")
+	}
+	if NewRat(1, 3).Cmp(NewRat(1, 2)) != -1 {
+		t.Errorf("1/3 should be smaller than 1/2")
+	}
+}
+
+func TestSolveLinear(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       [][]int
+		b       []int
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "unique",
+			a:    [][]int{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}},
+			b:    []int{8, -11, -3},
+			want: []string{"2", "3", "-1"},
+		},
+		{
+			name: "fractions and pivoting",
+			a:    [][]int{{0, 3}, {2, 1}},
+			b:    []int{1, 1},
+			want: []string{"1/3", "1/3"},
+		},
+		{
+			name:    "inconsistent",
+			a:       [][]int{{1, 1}, {2, 2}},
+			b:       []int{1, 3},
+			wantErr: ErrNoSolution,
+		},
+		{
+			name:    "underdetermined",
+			a:       [][]int{{1, 1}, {2, 2}},
+			b:       []int{1, 2},
+			wantErr: ErrInfiniteSolutions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SolveLinearInts(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SolveLinearInts() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("SolveLinearInts() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("SolveLinearInts() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	if _, err := SolveLinearInts([][]int{{1, 2}}, []int{1}); err == nil {
+		t.Errorf("SolveLinearInts() with non square matrix should fail")
+	}
+}
+
+func TestLinearize(t *testing.T) {
+	num := func(n int) *ExprNode {
+		return &ExprNode{Value: IntRat(n)}
+	}
+	op := func(op string, left, right *ExprNode) *ExprNode {
+		return &ExprNode{Op: op, Left: left, Right: right}
+	}
+	x := &ExprNode{Unknown: true}
+
+	// (4 + 2 * (x - 3)) / 4 == 150 is the day 21 example, x = 301
+	left := op("/", op("+", num(4), op("*", num(2), op("-", x, num(3)))), num(4))
+	lin, err := Linearize(left)
+	if err != nil {
+		t.Fatalf("Linearize() error = %v", err)
+	}
+	if lin.A.String() != "1/2" || lin.B.String() != "-1/2" {
+		t.Errorf("Linearize() = %v, want 1/2*x + -1/2", lin)
+	}
+	if got, err := lin.SolveEqual(Constant(IntRat(150))); err != nil || !got.Equal(IntRat(301)) {
+		t.Errorf("SolveEqual() = %v, %v, want 301", got, err)
+	}
+	if got := lin.Eval(IntRat(2)); got.String() != "1/2" {
+		t.Errorf("Eval(2) = %v, want 1/2", got)
+	}
+
+	// integer division would lose the fraction: x / 3 * 3 == 7
+	lin, _ = Linearize(op("*", op("/", x, num(3)), num(3)))
+	if got, _ := lin.SolveEqual(Constant(IntRat(7))); !got.Equal(IntRat(7)) {
+		t.Errorf("SolveEqual() = %v, want 7", got)
+	}
+
+	if _, err := Linearize(op("*", x, x)); err == nil {
+		t.Errorf("Linearize(x * x) should fail")
+	}
+	if _, err := Linearize(op("/", num(1), x)); err == nil {
+		t.Errorf("Linearize(1 / x) should fail")
+	}
+	if _, err := Unknown().SolveEqual(Unknown()); !errors.Is(err, ErrInfiniteSolutions) {
+		t.Errorf("x == x error = %v, want ErrInfiniteSolutions", err)
+	}
+	if _, err := Unknown().SolveEqual(Unknown().Add(Constant(IntRat(1)))); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("x == x + 1 error = %v, want ErrNoSolution", err)
+	}
+}
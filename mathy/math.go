@@ -1,43 +1,25 @@
 package mathy
 
+// MaxInt returns the largest int, 0 if nums is empty. See Max for other types
 func MaxInt(nums ...int) int {
-	maxNum := nums[0]
-	for _, v := range nums {
-		if v > maxNum {
-			maxNum = v
-		}
-	}
-	return maxNum
+	max, _ := Max(nums...)
+	return max
 }
+
+// MinInt returns the smallest int, 0 if nums is empty. See Min for other types
 func MinInt(nums ...int) int {
-	minNum := nums[0]
-	for _, v := range nums {
-		if v < minNum {
-			minNum = v
-		}
-	}
-	return minNum
+	min, _ := Min(nums...)
+	return min
 }
 
 func AbsInt(in int) int {
-	if in < 0 {
-		return -in
-	}
-	return in
+	return Abs(in)
 }
 
 func SumIntSlice(nums []int) int {
-	var sum int
-	for _, n := range nums {
-		sum += n
-	}
-	return sum
+	return Sum(nums...)
 }
 
 func MultiplyIntSlice(nums []int) int {
-	product := 1
-	for _, n := range nums {
-		product *= n
-	}
-	return product
+	return Product(nums...)
 }
@@ -0,0 +1,375 @@
+// Package matrix provides integer matrices with optional modular arithmetic,
+// e.g. fast exponentiation of linear recurrences, and exact determinants and
+// inverses over rationals
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mheidinger/advent-of-code-go/mathy"
+)
+
+// ErrSingular is returned when inverting a matrix with determinant 0
+var ErrSingular = errors.New("matrix is singular")
+
+// Matrix is a dense rows x cols matrix of int64 values
+// If it has a modulus all values are kept in [0, mod) and every operation is
+// done modulo mod, otherwise plain int64 arithmetic (that may overflow) is used
+type Matrix struct {
+	rows, cols int
+	mod        int64
+	data       []int64
+}
+
+// New returns a rows x cols matrix filled with zeros
+func New(rows, cols int) *Matrix {
+	return &Matrix{rows: rows, cols: cols, data: make([]int64, rows*cols)}
+}
+
+// NewMod returns a rows x cols matrix filled with zeros that calculates modulo mod
+func NewMod(rows, cols int, mod int64) *Matrix {
+	if mod <= 0 {
+		panic(fmt.Sprintf("modulus %d must be positive", mod))
+	}
+	m := New(rows, cols)
+	m.mod = mod
+	return m
+}
+
+// Identity returns the n x n identity matrix
+func Identity(n int) *Matrix {
+	m := New(n, n)
+	for i := 0; i < n; i++ {
+		m.data[i*n+i] = 1
+	}
+	return m
+}
+
+// IdentityMod returns the n x n identity matrix modulo mod
+func IdentityMod(n int, mod int64) *Matrix {
+	return Identity(n).WithMod(mod)
+}
+
+// FromSlices returns a matrix with a copy of the given rows, panics if the
+// rows have different lengths
+func FromSlices(rows [][]int64) *Matrix {
+	m := New(len(rows), 0)
+	if len(rows) > 0 {
+		m = New(len(rows), len(rows[0]))
+	}
+	for r, row := range rows {
+		if len(row) != m.cols {
+			panic(fmt.Sprintf("row %d has length %d, want %d", r, len(row), m.cols))
+		}
+		copy(m.data[r*m.cols:], row)
+	}
+	return m
+}
+
+// FromInts returns a matrix with a copy of an [][]int grid, e.g. as used by
+// algos.RotateIntGrid
+func FromInts(rows [][]int) *Matrix {
+	converted := make([][]int64, len(rows))
+	for r, row := range rows {
+		converted[r] = make([]int64, len(row))
+		for c, val := range row {
+			converted[r][c] = int64(val)
+		}
+	}
+	return FromSlices(converted)
+}
+
+// WithMod returns a copy of m that calculates modulo mod, 0 removes the modulus
+func (m *Matrix) WithMod(mod int64) *Matrix {
+	if mod < 0 {
+		panic(fmt.Sprintf("modulus %d must not be negative", mod))
+	}
+	res := m.Clone()
+	res.mod = mod
+	if mod != 0 {
+		for i, val := range res.data {
+			res.data[i] = mathy.Mod(val, mod)
+		}
+	}
+	return res
+}
+
+// Clone returns a deep copy of m
+func (m *Matrix) Clone() *Matrix {
+	res := *m
+	res.data = append([]int64(nil), m.data...)
+	return &res
+}
+
+// Rows returns the number of rows
+func (m *Matrix) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns
+func (m *Matrix) Cols() int {
+	return m.cols
+}
+
+// Mod returns the modulus of the matrix, 0 if it has none
+func (m *Matrix) Mod() int64 {
+	return m.mod
+}
+
+// At returns the value at row r and column c
+func (m *Matrix) At(r, c int) int64 {
+	m.check(r, c)
+	return m.data[r*m.cols+c]
+}
+
+// Set overwrites the value at row r and column c
+func (m *Matrix) Set(r, c int, val int64) {
+	m.check(r, c)
+	if m.mod != 0 {
+		val = mathy.Mod(val, m.mod)
+	}
+	m.data[r*m.cols+c] = val
+}
+
+func (m *Matrix) check(r, c int) {
+	if r < 0 || r >= m.rows || c < 0 || c >= m.cols {
+		panic(fmt.Sprintf("index (%d, %d) out of bounds for %dx%d matrix", r, c, m.rows, m.cols))
+	}
+}
+
+// Slices returns a copy of the matrix as rows of int64
+func (m *Matrix) Slices() [][]int64 {
+	rows := make([][]int64, m.rows)
+	for r := range rows {
+		rows[r] = append([]int64(nil), m.data[r*m.cols:(r+1)*m.cols]...)
+	}
+	return rows
+}
+
+// Ints returns a copy of the matrix as [][]int grid
+func (m *Matrix) Ints() [][]int {
+	rows := make([][]int, m.rows)
+	for r := range rows {
+		rows[r] = make([]int, m.cols)
+		for c := range rows[r] {
+			rows[r][c] = int(m.data[r*m.cols+c])
+		}
+	}
+	return rows
+}
+
+// Equal returns true if both matrices have the same size, modulus and values
+func (m *Matrix) Equal(o *Matrix) bool {
+	if m.rows != o.rows || m.cols != o.cols || m.mod != o.mod {
+		return false
+	}
+	for i := range m.data {
+		if m.data[i] != o.data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Transpose returns a new matrix with rows and columns swapped
+func (m *Matrix) Transpose() *Matrix {
+	res := New(m.cols, m.rows)
+	res.mod = m.mod
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			res.data[c*m.rows+r] = m.data[r*m.cols+c]
+		}
+	}
+	return res
+}
+
+// Mul returns the matrix product m * o, panics if the sizes or moduli do not match
+func (m *Matrix) Mul(o *Matrix) *Matrix {
+	if m.cols != o.rows {
+		panic(fmt.Sprintf("cannot multiply %dx%d with %dx%d matrix", m.rows, m.cols, o.rows, o.cols))
+	}
+	if m.mod != o.mod {
+		panic(fmt.Sprintf("cannot multiply matrices with moduli %d and %d", m.mod, o.mod))
+	}
+
+	res := New(m.rows, o.cols)
+	res.mod = m.mod
+	for r := 0; r < m.rows; r++ {
+		for k := 0; k < m.cols; k++ {
+			a := m.data[r*m.cols+k]
+			if a == 0 {
+				continue
+			}
+			for c := 0; c < o.cols; c++ {
+				i := r*o.cols + c
+				if m.mod == 0 {
+					res.data[i] += a * o.data[k*o.cols+c]
+				} else {
+					res.data[i] = addMod(res.data[i], mathy.MulMod(a, o.data[k*o.cols+c], m.mod), m.mod)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// addMod returns a+b mod m for a, b in [0, m) without overflowing
+func addMod(a, b, m int64) int64 {
+	if a >= m-b {
+		return a - (m - b)
+	}
+	return a + b
+}
+
+// MulVec returns the product m * v of the matrix with a column vector
+func (m *Matrix) MulVec(v []int64) []int64 {
+	col := New(len(v), 1)
+	col.mod = m.mod
+	for i, val := range v {
+		col.Set(i, 0, val)
+	}
+	return m.Mul(col).data
+}
+
+// Pow returns m^exp for a square matrix and exp >= 0 via square and multiply,
+// e.g. to advance a linear recurrence by a huge number of steps
+func (m *Matrix) Pow(exp int64) *Matrix {
+	if m.rows != m.cols {
+		panic(fmt.Sprintf("cannot exponentiate non square %dx%d matrix", m.rows, m.cols))
+	}
+	if exp < 0 {
+		panic("Pow does not support negative exponents, use Inverse")
+	}
+	res := Identity(m.rows).WithMod(m.mod)
+	base := m
+	for exp > 0 {
+		if exp&1 == 1 {
+			res = res.Mul(base)
+		}
+		base = base.Mul(base)
+		exp >>= 1
+	}
+	return res
+}
+
+// Rats returns the matrix as exact rationals, ignoring the modulus
+func (m *Matrix) Rats() [][]mathy.Rat {
+	rows := make([][]mathy.Rat, m.rows)
+	for r := range rows {
+		rows[r] = make([]mathy.Rat, m.cols)
+		for c := range rows[r] {
+			rows[r][c] = mathy.NewRat(m.data[r*m.cols+c], 1)
+		}
+	}
+	return rows
+}
+
+// Determinant returns the exact determinant of a square matrix, calculated
+// over the rationals (ignoring the modulus)
+func (m *Matrix) Determinant() mathy.Rat {
+	if m.rows != m.cols {
+		panic(fmt.Sprintf("determinant of non square %dx%d matrix", m.rows, m.cols))
+	}
+	a := m.Rats()
+	det := mathy.IntRat(1)
+	for col := 0; col < m.cols; col++ {
+		pivot := -1
+		for r := col; r < m.rows; r++ {
+			if !a[r][col].IsZero() {
+				pivot = r
+				break
+			}
+		}
+		if pivot == -1 {
+			return mathy.Rat{}
+		}
+		if pivot != col {
+			a[col], a[pivot] = a[pivot], a[col]
+			det = det.Neg()
+		}
+		det = det.Mul(a[col][col])
+		for r := col + 1; r < m.rows; r++ {
+			if a[r][col].IsZero() {
+				continue
+			}
+			factor := a[r][col].Quo(a[col][col])
+			for c := col; c < m.cols; c++ {
+				a[r][c] = a[r][c].Sub(factor.Mul(a[col][c]))
+			}
+		}
+	}
+	return det
+}
+
+// Inverse returns the exact inverse of a square matrix over the rationals
+// (ignoring the modulus), ErrSingular if it has none
+func (m *Matrix) Inverse() ([][]mathy.Rat, error) {
+	if m.rows != m.cols {
+		return nil, fmt.Errorf("inverse of non square %dx%d matrix", m.rows, m.cols)
+	}
+	n := m.rows
+	// Gauss-Jordan on [m | I]
+	a := m.Rats()
+	for r := range a {
+		for c := 0; c < n; c++ {
+			if r == c {
+				a[r] = append(a[r], mathy.IntRat(1))
+			} else {
+				a[r] = append(a[r], mathy.Rat{})
+			}
+		}
+	}
+	for col := 0; col < n; col++ {
+		pivot := -1
+		for r := col; r < n; r++ {
+			if !a[r][col].IsZero() {
+				pivot = r
+				break
+			}
+		}
+		if pivot == -1 {
+			return nil, ErrSingular
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+
+		scale := a[col][col]
+		for c := range a[col] {
+			a[col][c] = a[col][c].Quo(scale)
+		}
+		for r := 0; r < n; r++ {
+			if r == col || a[r][col].IsZero() {
+				continue
+			}
+			factor := a[r][col]
+			for c := range a[r] {
+				a[r][c] = a[r][c].Sub(factor.Mul(a[col][c]))
+			}
+		}
+	}
+
+	inverse := make([][]mathy.Rat, n)
+	for r := range inverse {
+		inverse[r] = a[r][n:]
+	}
+	return inverse, nil
+}
+
+// String returns the rows of the matrix separated by newlines, with the
+// values of a row separated by spaces
+func (m *Matrix) String() string {
+	var b strings.Builder
+	for r := 0; r < m.rows; r++ {
+		if r > 0 {
+			b.WriteByte('\n')
+		}
+		for c := 0; c < m.cols; c++ {
+			if c > 0 {
+				b.WriteByte(' ')
+			}
+			fmt.Fprint(&b, m.data[r*m.cols+c])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+package matrix_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/algos"
+	"github.com/mheidinger/advent-of-code-go/mathy/matrix"
+)
+
+func TestMulAndTranspose(t *testing.T) {
+	a := matrix.FromInts([][]int{{1, 2, 3}, {4, 5, 6}})
+	b := a.Transpose()
+	if got := b.Ints(); !reflect.DeepEqual(got, [][]int{{1, 4}, {2, 5}, {3, 6}}) {
+		t.Errorf("Transpose() = %v", got)
+	}
+	if got := a.Mul(b).Ints(); !reflect.DeepEqual(got, [][]int{{14, 32}, {32, 77}}) {
+		t.Errorf("Mul() = %v", got)
+	}
+	if got := a.MulVec([]int64{1, 0, -1}); !reflect.DeepEqual(got, []int64{-2, -2}) {
+		t.Errorf("MulVec() = %v", got)
+	}
+	if !a.Mul(matrix.Identity(3)).Equal(a) {
+		t.Errorf("a * I != a")
+	}
+	if got := a.String(); got != "1 2 3\n4 5 6" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestIntGridInterop(t *testing.T) {
+	grid := [][]int{{1, 2}, {3, 4}}
+	rotated := matrix.FromInts(algos.RotateIntGrid(grid))
+	// rotating counterclockwise equals reversing the rows of the transpose
+	want := matrix.FromInts([][]int{{2, 4}, {1, 3}})
+	if !rotated.Equal(want) {
+		t.Errorf("FromInts(RotateIntGrid()) = %v, want %v", rotated, want)
+	}
+}
+
+func TestPow(t *testing.T) {
+	fib := matrix.FromInts([][]int{{1, 1}, {1, 0}})
+	if got := fib.Pow(10).At(0, 1); got != 55 {
+		t.Errorf("fib(10) = %d, want 55", got)
+	}
+	if !fib.Pow(0).Equal(matrix.Identity(2)) {
+		t.Errorf("Pow(0) should be the identity")
+	}
+
+	// fib(90) is the largest one to compare against that fits into an int64
+	mod := int64(1_000_000_007)
+	if got, want := fib.WithMod(mod).Pow(90).At(0, 1), int64(2880067194370816120)%mod; got != want {
+		t.Errorf("fib(90) mod p = %d, want %d", got, want)
+	}
+	if got := fib.WithMod(mod).Pow(1_000_000_000_000).At(0, 1); got < 0 || got >= mod {
+		t.Errorf("fib(10^12) mod p = %d, out of range", got)
+	}
+
+	neg := matrix.NewMod(1, 1, 7)
+	neg.Set(0, 0, -1)
+	if got := neg.At(0, 0); got != 6 {
+		t.Errorf("Set(-1) mod 7 = %d, want 6", got)
+	}
+}
+
+func TestDeterminantAndInverse(t *testing.T) {
+	m := matrix.FromInts([][]int{{2, 0, 1}, {1, 3, 2}, {1, 1, 2}})
+	if got := m.Determinant().String(); got != "6" {
+		t.Errorf("Determinant() = %s, want 6", got)
+	}
+	if got := matrix.FromInts([][]int{{0, 1}, {1, 0}}).Determinant().String(); got != "-1" {
+		t.Errorf("Determinant() with row swap = %s, want -1", got)
+	}
+
+	inv, err := matrix.FromInts([][]int{{4, 7}, {2, 6}}).Inverse()
+	if err != nil {
+		t.Fatalf("Inverse() error = %v", err)
+	}
+	want := [][]string{{"3/5", "-7/10"}, {"-1/5", "2/5"}}
+	for r := range want {
+		for c := range want[r] {
+			if got := inv[r][c].String(); got != want[r][c] {
+				t.Errorf("Inverse()[%d][%d] = %s, want %s", r, c, got, want[r][c])
+			}
+		}
+	}
+
+	singular := matrix.FromInts([][]int{{1, 2}, {2, 4}})
+	if !singular.Determinant().IsZero() {
+		t.Errorf("Determinant() of singular matrix = %v, want 0", singular.Determinant())
+	}
+	if _, err := singular.Inverse(); !errors.Is(err, matrix.ErrSingular) {
+		t.Errorf("Inverse() error = %v, want ErrSingular", err)
+	}
+}
@@ -0,0 +1,134 @@
+package mathy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// ErrNoSolution is returned if a system of congruences or a modular inverse
+// has no solution
+var ErrNoSolution = errors.New("no solution")
+
+// GCD returns the greatest common divisor of a and b, always >= 0
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return AbsInt(a)
+}
+
+// LCM returns the least common multiple of all nums, 0 if any of them is 0
+func LCM(nums ...int) int {
+	lcm := 1
+	for _, n := range nums {
+		if n == 0 {
+			return 0
+		}
+		lcm = AbsInt(lcm / GCD(lcm, n) * n)
+	}
+	return lcm
+}
+
+// ExtendedGCD returns gcd(a, b) and x, y such that a*x + b*y = gcd(a, b)
+func ExtendedGCD(a, b int64) (gcd, x, y int64) {
+	oldR, r := a, b
+	oldX, x := int64(1), int64(0)
+	oldY, y := int64(0), int64(1)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// Mod returns a modulo m in the range [0, m), unlike % for negative a
+func Mod(a, m int64) int64 {
+	a %= m
+	if a < 0 {
+		a += m
+	}
+	return a
+}
+
+// ModInverse returns x in [0, m) with a*x = 1 (mod m), ErrNoSolution if a and
+// m are not coprime
+func ModInverse(a, m int64) (int64, error) {
+	gcd, x, _ := ExtendedGCD(Mod(a, m), m)
+	if gcd != 1 {
+		return 0, fmt.Errorf("inverse of %d mod %d: %w", a, m, ErrNoSolution)
+	}
+	return Mod(x, m), nil
+}
+
+// MulMod returns a*b mod m in [0, m) without overflowing, even if a*b does not
+// fit into an int64, panics if m is not positive
+func MulMod(a, b, m int64) int64 {
+	if m <= 0 {
+		panic(fmt.Sprintf("MulMod needs a positive modulus, got %d", m))
+	}
+	hi, lo := bits.Mul64(uint64(Mod(a, m)), uint64(Mod(b, m)))
+	// hi < m is guaranteed as both factors are < m
+	_, rem := bits.Div64(hi, lo, uint64(m))
+	return int64(rem)
+}
+
+// PowMod returns base^exp mod m in [0, m) via square and multiply, exp >= 0
+func PowMod(base, exp, m int64) int64 {
+	if exp < 0 {
+		panic("PowMod does not support negative exponents, use ModInverse")
+	}
+	result := Mod(1, m)
+	base = Mod(base, m)
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = MulMod(result, base, m)
+		}
+		base = MulMod(base, base, m)
+		exp >>= 1
+	}
+	return result
+}
+
+// CRT solves the system x = remainders[i] (mod moduli[i]) with the Chinese
+// Remainder Theorem. The moduli do NOT have to be coprime. It returns the
+// smallest x >= 0 and the combined modulus (the lcm of all moduli), i.e. all
+// solutions are x + k*lcm
+// ErrNoSolution is returned if the congruences contradict each other
+func CRT(remainders, moduli []int64) (x, lcm int64, err error) {
+	if len(remainders) != len(moduli) {
+		return 0, 0, fmt.Errorf("got %d remainders but %d moduli", len(remainders), len(moduli))
+	}
+
+	x, lcm = 0, 1
+	for i := range moduli {
+		m := moduli[i]
+		if m <= 0 {
+			return 0, 0, fmt.Errorf("modulus %d must be positive", m)
+		}
+		a := Mod(remainders[i], m)
+
+		// x + lcm*k = a (mod m) => lcm*k = a - x (mod m)
+		gcd, inv, _ := ExtendedGCD(lcm, m)
+		diff := a - Mod(x, m)
+		if diff%gcd != 0 {
+			return 0, 0, fmt.Errorf("x = %d (mod %d) contradicts previous congruences: %w", remainders[i], m, ErrNoSolution)
+		}
+		step := m / gcd
+		if lcm > math.MaxInt64/step {
+			return 0, 0, fmt.Errorf("combined modulus overflows int64")
+		}
+		k := MulMod(diff/gcd, inv, step)
+		newLcm := lcm * step
+		// both summands are < newLcm <= MaxInt64 so the uint64 sum cannot overflow
+		x = int64((uint64(x) + uint64(MulMod(lcm, k, newLcm))) % uint64(newLcm))
+		lcm = newLcm
+	}
+	return x, lcm, nil
+}
@@ -0,0 +1,110 @@
+package mathy
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestGCDAndLCM(t *testing.T) {
+	if got := GCD(12, 18); got != 6 {
+		t.Errorf("GCD(12, 18) = %d, want 6", got)
+	}
+	if got := GCD(-12, 18); got != 6 {
+		t.Errorf("GCD(-12, 18) = %d, want 6", got)
+	}
+	if got := GCD(0, 7); got != 7 {
+		t.Errorf("GCD(0, 7) = %d, want 7", got)
+	}
+	// day 11 example monkey divisors
+	if got := LCM(23, 19, 13, 17); got != 96577 {
+		t.Errorf("LCM(23, 19, 13, 17) = %d, want 96577", got)
+	}
+	if got := LCM(4, 6, 10); got != 60 {
+		t.Errorf("LCM(4, 6, 10) = %d, want 60", got)
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	for _, tt := range [][2]int64{{240, 46}, {-240, 46}, {17, 5}, {0, 9}} {
+		gcd, x, y := ExtendedGCD(tt[0], tt[1])
+		if tt[0]*x+tt[1]*y != gcd || gcd < 0 {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d, Bezout identity does not hold", tt[0], tt[1], gcd, x, y)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	if got, err := ModInverse(3, 11); err != nil || got != 4 {
+		t.Errorf("ModInverse(3, 11) = %d, %v, want 4", got, err)
+	}
+	if got, err := ModInverse(-3, 11); err != nil || got != 7 {
+		t.Errorf("ModInverse(-3, 11) = %d, %v, want 7", got, err)
+	}
+	if _, err := ModInverse(6, 9); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("ModInverse(6, 9) error = %v, want ErrNoSolution", err)
+	}
+}
+
+func TestMulModAndPowMod(t *testing.T) {
+	m := int64(math.MaxInt64 - 24) // large enough that a*b overflows
+	a, b := m-1, m-2
+	want := new(big.Int).Mod(new(big.Int).Mul(big.NewInt(a), big.NewInt(b)), big.NewInt(m)).Int64()
+	if got := MulMod(a, b, m); got != want {
+		t.Errorf("MulMod() = %d, want %d", got, want)
+	}
+
+	want = new(big.Int).Exp(big.NewInt(a), big.NewInt(12345), big.NewInt(m)).Int64()
+	if got := PowMod(a, 12345, m); got != want {
+		t.Errorf("PowMod() = %d, want %d", got, want)
+	}
+	if got := PowMod(2, 10, 1000); got != 24 {
+		t.Errorf("PowMod(2, 10, 1000) = %d, want 24", got)
+	}
+	if got := PowMod(5, 0, 1); got != 0 {
+		t.Errorf("PowMod(5, 0, 1) = %d, want 0", got)
+	}
+}
+
+func TestMulModNonPositiveModulus(t *testing.T) {
+	for _, m := range []int64{0, -7} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("MulMod(3, 4, %d) should panic", m)
+				}
+			}()
+			MulMod(3, 4, m)
+		}()
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name       string
+		remainders []int64
+		moduli     []int64
+		wantX      int64
+		wantLcm    int64
+		wantErr    error
+	}{
+		{"coprime", []int64{2, 3, 2}, []int64{3, 5, 7}, 23, 105, nil},
+		{"not coprime", []int64{2, 4}, []int64{6, 8}, 20, 24, nil},
+		{"negative remainder", []int64{-1, 0}, []int64{4, 3}, 3, 12, nil},
+		// AoC 2020 day 13 example: 7,13,x,x,59,x,31,19
+		{"bus schedule", []int64{0, -1, -4, -6, -7}, []int64{7, 13, 59, 31, 19}, 1068781, 3162341, nil},
+		{"contradiction", []int64{1, 2}, []int64{4, 6}, 0, 0, ErrNoSolution},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, lcm, err := CRT(tt.remainders, tt.moduli)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CRT() error = %v, want %v", err, tt.wantErr)
+			}
+			if x != tt.wantX || lcm != tt.wantLcm {
+				t.Errorf("CRT() = %d, %d, want %d, %d", x, lcm, tt.wantX, tt.wantLcm)
+			}
+		})
+	}
+}
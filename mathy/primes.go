@@ -2,33 +2,244 @@ package mathy
 
 import (
 	"math"
+	"math/bits"
+	"sort"
 )
 
 // GeneratePrimes returns the n-th prime number
 // its param primes []int is intended to contain previously found prime numbers
 // to reduce duplicated work, but still be testable
 func GeneratePrimes(primes []int, n int) int {
-	if len(primes) < 2 {
-		primes = []int{2, 3}
-	}
 	if len(primes) >= n {
 		return primes[n-1]
 	}
-	for i := primes[len(primes)-1] + 2; len(primes) <= n; i += 2 {
-		// check if i is a prime number by checking if it is divisible by any of the previous values of primes
-		// stop at the square root of i
-		for _, v := range primes {
-			// not a prime, stop this loop
-			if i%v == 0 {
+	// the n-th prime is smaller than n * (ln n + ln ln n) for n >= 6
+	limit := 15
+	if n >= 6 {
+		ln := math.Log(float64(n))
+		limit = int(float64(n) * (ln + math.Log(ln)))
+	}
+	return PrimesUpTo(limit)[n-1]
+}
+
+// PrimesUpTo returns all prime numbers <= n in ascending order
+func PrimesUpTo(n int) []int {
+	return SegmentedSieve(2, n)
+}
+
+// sieveSegmentSize is chosen so one segment fits into the L1/L2 cache
+const sieveSegmentSize = 1 << 15
+
+// SegmentedSieve returns all prime numbers in [lo, hi] in ascending order
+// using a Sieve of Eratosthenes that only keeps one segment of the range in
+// memory at a time
+func SegmentedSieve(lo, hi int) []int {
+	if lo < 2 {
+		lo = 2
+	}
+	if hi < lo {
+		return nil
+	}
+
+	basePrimes := simpleSieve(int(math.Sqrt(float64(hi))))
+	// estimate the number of primes in the range to avoid reallocations
+	var primes []int
+	if estimate := int(float64(hi)/math.Log(float64(hi+1))*1.3 - float64(lo)/math.Log(float64(lo+1))); estimate > 0 {
+		primes = make([]int, 0, estimate)
+	}
+
+	composite := make([]bool, sieveSegmentSize)
+	for segStart := lo; segStart <= hi; segStart += sieveSegmentSize {
+		segEnd := segStart + sieveSegmentSize - 1
+		if segEnd > hi {
+			segEnd = hi
+		}
+		for i := range composite {
+			composite[i] = false
+		}
+
+		for _, p := range basePrimes {
+			if p*p > segEnd {
 				break
 			}
-			if math.Sqrt(float64(i)) < float64(v) {
-				// add to primes
-				primes = append(primes, i)
+			// first multiple of p in the segment, but not p itself
+			start := (segStart + p - 1) / p * p
+			if start < p*p {
+				start = p * p
+			}
+			for multiple := start; multiple <= segEnd; multiple += p {
+				composite[multiple-segStart] = true
+			}
+		}
+
+		for i := 0; i <= segEnd-segStart; i++ {
+			if !composite[i] {
+				primes = append(primes, segStart+i)
+			}
+		}
+	}
+	return primes
+}
+
+// simpleSieve returns all primes <= n with a plain Sieve of Eratosthenes
+func simpleSieve(n int) []int {
+	if n < 2 {
+		return nil
+	}
+	composite := make([]bool, n+1)
+	var primes []int
+	for i := 2; i <= n; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for multiple := i * i; multiple <= n; multiple += i {
+			composite[multiple] = true
+		}
+	}
+	return primes
+}
+
+// millerRabinBases make Miller-Rabin deterministic for all n < 2^64
+var millerRabinBases = []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// IsPrime returns true if n is a prime number, using a deterministic
+// Miller-Rabin test
+func IsPrime(n int64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	// n-1 = d * 2^s with d odd
+	d := n - 1
+	s := bits.TrailingZeros64(uint64(d))
+	d >>= s
+
+	for _, a := range millerRabinBases {
+		x := PowMod(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for r := 1; r < s; r++ {
+			x = MulMod(x, x, n)
+			if x == n-1 {
+				composite = false
 				break
 			}
 		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// PrimePower is a factor Prime^Exp of a prime factorization
+type PrimePower struct {
+	Prime int64
+	Exp   int
+}
+
+// Factorize returns the prime factorization of n > 0 sorted by prime, i.e.
+// 360 => 2^3, 3^2, 5^1. Small factors are found by trial division, large ones
+// with Pollard's rho
+func Factorize(n int64) []PrimePower {
+	if n < 1 {
+		panic("can only factorize positive numbers")
+	}
+
+	counts := map[int64]int{}
+	for _, p := range smallPrimes {
+		for n%p == 0 {
+			counts[p]++
+			n /= p
+		}
+	}
+	if n > 1 {
+		factorizeRho(n, counts)
+	}
+
+	factors := make([]PrimePower, 0, len(counts))
+	for p, exp := range counts {
+		factors = append(factors, PrimePower{p, exp})
+	}
+	sort.Slice(factors, func(i, j int) bool {
+		return factors[i].Prime < factors[j].Prime
+	})
+	return factors
+}
+
+var smallPrimes = func() []int64 {
+	var primes []int64
+	for _, p := range simpleSieve(1000) {
+		primes = append(primes, int64(p))
+	}
+	return primes
+}()
+
+// factorizeRho splits n (without factors < 1000) into primes via Pollard's rho
+func factorizeRho(n int64, counts map[int64]int) {
+	if n == 1 {
+		return
+	}
+	if IsPrime(n) {
+		counts[n]++
+		return
+	}
+	divisor := pollardRho(n)
+	factorizeRho(divisor, counts)
+	factorizeRho(n/divisor, counts)
+}
+
+// pollardRho returns a non-trivial divisor of the composite number n
+func pollardRho(n int64) int64 {
+	for c := int64(1); ; c++ {
+		f := func(x int64) int64 {
+			return int64((uint64(MulMod(x, x, n)) + uint64(c)) % uint64(n))
+		}
+		x, y, d := int64(2), int64(2), int64(1)
+		for d == 1 {
+			x = f(x)
+			y = f(f(y))
+			d = int64(GCD(int(x-y), int(n)))
+		}
+		if d != n {
+			return d
+		}
+	}
+}
+
+// Divisors returns all positive divisors of n > 0 in ascending order
+func Divisors(n int64) []int64 {
+	divisors := []int64{1}
+	for _, factor := range Factorize(n) {
+		existing := len(divisors)
+		power := int64(1)
+		for e := 0; e < factor.Exp; e++ {
+			power *= factor.Prime
+			for _, d := range divisors[:existing] {
+				divisors = append(divisors, d*power)
+			}
+		}
 	}
+	sort.Slice(divisors, func(i, j int) bool {
+		return divisors[i] < divisors[j]
+	})
+	return divisors
+}
 
-	return primes[n-1]
+// Totient returns Euler's totient of n > 0, i.e. the count of numbers in
+// [1, n] that are coprime to n
+func Totient(n int64) int64 {
+	result := n
+	for _, factor := range Factorize(n) {
+		result = result / factor.Prime * (factor.Prime - 1)
+	}
+	return result
 }
@@ -2,6 +2,8 @@ package mathy
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 	"testing"
 )
 
@@ -19,6 +21,10 @@ func TestGeneratePrimes(t *testing.T) {
 		{[]int{2, 3, 5, 7}, 6, 13},
 		{[]int{2, 3}, 7, 17},
 		{[]int{2, 3}, 8, 19},
+		{nil, 1, 2},
+		{nil, 6, 13},
+		{nil, 1000, 7919},
+		{nil, 10000, 104729},
 	}
 	for _, test := range tests {
 		t.Run(fmt.Sprintf("%v-th prime number", test.n),
@@ -32,6 +38,118 @@ func TestGeneratePrimes(t *testing.T) {
 	}
 }
 
+func TestPrimesUpTo(t *testing.T) {
+	want := []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}
+	if got := PrimesUpTo(30); !reflect.DeepEqual(got, want) {
+		t.Errorf("PrimesUpTo(30) = %v, want %v", got, want)
+	}
+	if got := PrimesUpTo(1); len(got) != 0 {
+		t.Errorf("PrimesUpTo(1) = %v, want none", got)
+	}
+	// crosses multiple sieve segments
+	if got := len(PrimesUpTo(1000000)); got != 78498 {
+		t.Errorf("len(PrimesUpTo(1000000)) = %d, want 78498", got)
+	}
+}
+
+func TestSegmentedSieve(t *testing.T) {
+	want := []int{100003, 100019, 100043, 100049, 100057, 100069}
+	if got := SegmentedSieve(100000, 100070); !reflect.DeepEqual(got, want) {
+		t.Errorf("SegmentedSieve(100000, 100070) = %v, want %v", got, want)
+	}
+}
+
+func TestIsPrime(t *testing.T) {
+	// compare against the sieve for small numbers
+	sieved := map[int]bool{}
+	for _, p := range PrimesUpTo(10000) {
+		sieved[p] = true
+	}
+	for n := -1; n <= 10000; n++ {
+		if got := IsPrime(int64(n)); got != sieved[n] {
+			t.Fatalf("IsPrime(%d) = %v, want %v", n, got, sieved[n])
+		}
+	}
+
+	tests := []struct {
+		n    int64
+		want bool
+	}{
+		{3215031751, false}, // strong pseudoprime to bases 2, 3, 5 and 7
+		{1000000007, true},
+		{999999999989, true},
+		{math.MaxInt64, false},
+		{9223372036854775783, true}, // largest prime < 2^63
+	}
+	for _, tt := range tests {
+		if got := IsPrime(tt.n); got != tt.want {
+			t.Errorf("IsPrime(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want []PrimePower
+	}{
+		{1, []PrimePower{}},
+		{360, []PrimePower{{2, 3}, {3, 2}, {5, 1}}},
+		{1000000007, []PrimePower{{1000000007, 1}}},
+		{1000000007 * 998244353, []PrimePower{{998244353, 1}, {1000000007, 1}}},
+		{math.MaxInt64, []PrimePower{{7, 2}, {73, 1}, {127, 1}, {337, 1}, {92737, 1}, {649657, 1}}},
+	}
+	for _, tt := range tests {
+		if got := Factorize(tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Factorize(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDivisorsAndTotient(t *testing.T) {
+	want := []int64{1, 2, 3, 4, 6, 12}
+	if got := Divisors(12); !reflect.DeepEqual(got, want) {
+		t.Errorf("Divisors(12) = %v, want %v", got, want)
+	}
+	if got := len(Divisors(720720)); got != 240 {
+		t.Errorf("len(Divisors(720720)) = %d, want 240", got)
+	}
+
+	for n, want := range map[int64]int64{1: 1, 9: 6, 36: 12, 97: 96, 1000000: 400000} {
+		if got := Totient(n); got != want {
+			t.Errorf("Totient(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+// generatePrimesTrialDivision is the previous implementation of GeneratePrimes
+// kept around to compare it against the sieve in the benchmarks
+func generatePrimesTrialDivision(primes []int, n int) int {
+	if len(primes) < 2 {
+		primes = []int{2, 3}
+	}
+	if len(primes) >= n {
+		return primes[n-1]
+	}
+	for i := primes[len(primes)-1] + 2; len(primes) <= n; i += 2 {
+		// check if i is a prime number by checking if it is divisible by any of the previous values of primes
+		// stop at the square root of i
+		for _, v := range primes {
+			// not a prime, stop this loop
+			if i%v == 0 {
+				break
+			}
+			if math.Sqrt(float64(i)) < float64(v) {
+				// add to primes
+				primes = append(primes, i)
+				break
+			}
+		}
+	}
+
+	return primes[n-1]
+}
+
 // run go test -bench=. from within this util folder
 // Benchmark
 func benchGenPrimes(n int, b *testing.B) {
@@ -40,13 +158,42 @@ func benchGenPrimes(n int, b *testing.B) {
 	}
 }
 
+func benchGenPrimesTrialDivision(n int, b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		generatePrimesTrialDivision([]int{2, 3}, n)
+	}
+}
+
 // Benchmark generating different magnitudes of primes...
-func BenchmarkGeneratePrimes10(b *testing.B)      { benchGenPrimes(10, b) }
-func BenchmarkGeneratePrimes100(b *testing.B)     { benchGenPrimes(100, b) }
-func BenchmarkGeneratePrimes1000(b *testing.B)    { benchGenPrimes(1000, b) }
-func BenchmarkGeneratePrimes10000(b *testing.B)   { benchGenPrimes(10000, b) }
-func BenchmarkGeneratePrimes100000(b *testing.B)  { benchGenPrimes(100000, b) }
-func BenchmarkGeneratePrimes1000000(b *testing.B) { benchGenPrimes(1000000, b) }
+func BenchmarkGeneratePrimes10(b *testing.B)       { benchGenPrimes(10, b) }
+func BenchmarkGeneratePrimes100(b *testing.B)      { benchGenPrimes(100, b) }
+func BenchmarkGeneratePrimes1000(b *testing.B)     { benchGenPrimes(1000, b) }
+func BenchmarkGeneratePrimes10000(b *testing.B)    { benchGenPrimes(10000, b) }
+func BenchmarkGeneratePrimes100000(b *testing.B)   { benchGenPrimes(100000, b) }
+func BenchmarkGeneratePrimes1000000(b *testing.B)  { benchGenPrimes(1000000, b) }
+func BenchmarkGeneratePrimes10000000(b *testing.B) { benchGenPrimes(10000000, b) }
+
+// ...and compare them against the old trial division
+func BenchmarkGeneratePrimesTrialDivision10(b *testing.B) {
+	benchGenPrimesTrialDivision(10, b)
+}
+func BenchmarkGeneratePrimesTrialDivision100(b *testing.B) {
+	benchGenPrimesTrialDivision(100, b)
+}
+func BenchmarkGeneratePrimesTrialDivision1000(b *testing.B) {
+	benchGenPrimesTrialDivision(1000, b)
+}
+func BenchmarkGeneratePrimesTrialDivision10000(b *testing.B) {
+	benchGenPrimesTrialDivision(10000, b)
+}
+func BenchmarkGeneratePrimesTrialDivision100000(b *testing.B) {
+	benchGenPrimesTrialDivision(100000, b)
+}
+func BenchmarkGeneratePrimesTrialDivision1000000(b *testing.B) {
+	benchGenPrimesTrialDivision(1000000, b)
+}
 
 // takes ~2 minutes on my mac
-// func BenchmarkGeneratePrimes10000000(b *testing.B) { benchGenPrimes(10000000, b) }
+// func BenchmarkGeneratePrimesTrialDivision10000000(b *testing.B) {
+// 	benchGenPrimesTrialDivision(10000000, b)
+// }
@@ -0,0 +1,105 @@
+package mathy
+
+import "math/big"
+
+// Rat is an immutable, exact rational number backed by a math/big.Rat so
+// intermediate results never overflow. The zero value is 0
+type Rat struct {
+	v *big.Rat
+}
+
+// NewRat returns num/den, panics if den is 0
+func NewRat(num, den int64) Rat {
+	if den == 0 {
+		panic("rational with zero denominator")
+	}
+	return Rat{big.NewRat(num, den)}
+}
+
+// IntRat returns the rational representation of n
+func IntRat(n int) Rat {
+	return Rat{new(big.Rat).SetInt64(int64(n))}
+}
+
+// BigRat returns a Rat with a copy of r
+func BigRat(r *big.Rat) Rat {
+	return Rat{new(big.Rat).Set(r)}
+}
+
+func (a Rat) big() *big.Rat {
+	if a.v == nil {
+		return new(big.Rat)
+	}
+	return a.v
+}
+
+// Add returns a + b
+func (a Rat) Add(b Rat) Rat {
+	return Rat{new(big.Rat).Add(a.big(), b.big())}
+}
+
+// Sub returns a - b
+func (a Rat) Sub(b Rat) Rat {
+	return Rat{new(big.Rat).Sub(a.big(), b.big())}
+}
+
+// Mul returns a * b
+func (a Rat) Mul(b Rat) Rat {
+	return Rat{new(big.Rat).Mul(a.big(), b.big())}
+}
+
+// Quo returns a / b, panics if b is 0
+func (a Rat) Quo(b Rat) Rat {
+	if b.IsZero() {
+		panic("division by zero")
+	}
+	return Rat{new(big.Rat).Quo(a.big(), b.big())}
+}
+
+// Neg returns -a
+func (a Rat) Neg() Rat {
+	return Rat{new(big.Rat).Neg(a.big())}
+}
+
+// Cmp returns -1, 0 or 1 if a is smaller, equal or larger than b
+func (a Rat) Cmp(b Rat) int {
+	return a.big().Cmp(b.big())
+}
+
+// Equal returns true if a == b
+func (a Rat) Equal(b Rat) bool {
+	return a.Cmp(b) == 0
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of a
+func (a Rat) Sign() int {
+	return a.big().Sign()
+}
+
+// IsZero returns true if a == 0
+func (a Rat) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// IsInt returns true if the denominator of a is 1
+func (a Rat) IsInt() bool {
+	return a.big().IsInt()
+}
+
+// Int returns a as an int, ok is false if a is not an integer or does not fit
+func (a Rat) Int() (n int, ok bool) {
+	if !a.IsInt() || !a.big().Num().IsInt64() {
+		return 0, false
+	}
+	return int(a.big().Num().Int64()), true
+}
+
+// Big returns a copy of the underlying big.Rat
+func (a Rat) Big() *big.Rat {
+	return new(big.Rat).Set(a.big())
+}
+
+// String returns "num/den" or just "num" for integers
+func (a Rat) String() string {
+	return a.big().RatString()
+}
@@ -0,0 +1,141 @@
+package mathy
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrNotPolynomial is returned if a sequence does not (yet) follow a polynomial
+// of the requested degree
+var ErrNotPolynomial = errors.New("sequence is not polynomial")
+
+// Differences returns the first differences seq[i+1] - seq[i]
+func Differences(seq []int) []int {
+	if len(seq) < 2 {
+		return nil
+	}
+	diffs := make([]int, len(seq)-1)
+	for i := range diffs {
+		diffs[i] = seq[i+1] - seq[i]
+	}
+	return diffs
+}
+
+// DifferenceTable returns seq followed by its repeated differences until a row
+// is all zeros or only has a single value left
+func DifferenceTable(seq []int) [][]int {
+	table := [][]int{seq}
+	for row := seq; len(row) > 1 && !allEqual(row, 0); {
+		row = Differences(row)
+		table = append(table, row)
+	}
+	return table
+}
+
+func allEqual(seq []int, val int) bool {
+	for _, v := range seq {
+		if v != val {
+			return false
+		}
+	}
+	return true
+}
+
+// PolynomialDegree returns the smallest degree d for which the d-th differences
+// of seq are constant, i.e. seq follows a polynomial of degree d (1 is an
+// arithmetic sequence). ok is false if seq is too short to confirm any degree,
+// at least d+2 values are needed
+func PolynomialDegree(seq []int) (degree int, ok bool) {
+	row := seq
+	for d := 0; len(row) >= 2; d++ {
+		if allEqual(row, row[0]) {
+			return d, true
+		}
+		row = Differences(row)
+	}
+	return 0, false
+}
+
+// DetectPolynomial finds the first index from which on seq follows a
+// polynomial of degree <= maxDegree, confirmed by at least confirm additional
+// values (confirm >= 1). Useful for simulations that settle after a warm-up
+func DetectPolynomial(seq []int, maxDegree, confirm int) (start, degree int, ok bool) {
+	if confirm < 1 {
+		confirm = 1
+	}
+	for start = 0; start < len(seq); start++ {
+		suffix := seq[start:]
+		degree, ok = PolynomialDegree(suffix)
+		if ok && degree <= maxDegree && len(suffix) >= degree+1+confirm {
+			return start, degree, true
+		}
+	}
+	return 0, 0, false
+}
+
+// ExtrapolateDifferences returns the exact value at index n (may be far beyond
+// len(seq) or negative) of the polynomial sequence seq via Newton's forward
+// difference formula f(n) = sum_k binomial(n, k) * Δ^k f(0)
+// ErrNotPolynomial is returned if seq is too short to determine its degree
+func ExtrapolateDifferences(seq []int, n int64) (*big.Int, error) {
+	degree, ok := PolynomialDegree(seq)
+	if !ok {
+		return nil, fmt.Errorf("%d values: %w", len(seq), ErrNotPolynomial)
+	}
+	table := DifferenceTable(seq)
+
+	result := new(big.Int)
+	binomial := big.NewInt(1) // binomial(n, 0)
+	bigN := big.NewInt(n)
+	term := new(big.Int)
+	for k := 0; k <= degree; k++ {
+		if k > 0 {
+			// binomial(n, k) = binomial(n, k-1) * (n-k+1) / k, always exact
+			binomial.Mul(binomial, term.Sub(bigN, big.NewInt(int64(k-1))))
+			binomial.Quo(binomial, big.NewInt(int64(k)))
+		}
+		term.Mul(binomial, big.NewInt(int64(table[k][0])))
+		result.Add(result, term)
+	}
+	return result, nil
+}
+
+// Extrapolate detects where seq becomes polynomial of degree <= maxDegree
+// (confirmed by at least one extra value) and returns its exact value at index n
+func Extrapolate(seq []int, maxDegree int, n int64) (*big.Int, error) {
+	start, _, ok := DetectPolynomial(seq, maxDegree, 1)
+	if !ok {
+		return nil, fmt.Errorf("degree <= %d: %w", maxDegree, ErrNotPolynomial)
+	}
+	if n >= 0 && n < int64(start) {
+		return big.NewInt(int64(seq[n])), nil
+	}
+	return ExtrapolateDifferences(seq[start:], n-int64(start))
+}
+
+// Lagrange returns the value at x of the unique polynomial of degree
+// < len(xs) through the points (xs[i], ys[i]). The xs have to be distinct
+// The result is exact and can be fractional
+func Lagrange(xs, ys []int64, x int64) (Rat, error) {
+	if len(xs) != len(ys) {
+		return Rat{}, fmt.Errorf("got %d x values but %d y values", len(xs), len(ys))
+	}
+	result := new(big.Rat)
+	for i := range xs {
+		num := big.NewInt(ys[i])
+		den := big.NewInt(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			if xs[i] == xs[j] {
+				return Rat{}, fmt.Errorf("duplicate x value %d", xs[i])
+			}
+			num.Mul(num, new(big.Int).Sub(big.NewInt(x), big.NewInt(xs[j])))
+			den.Mul(den, new(big.Int).Sub(big.NewInt(xs[i]), big.NewInt(xs[j])))
+		}
+		result.Add(result, new(big.Rat).SetFrac(num, den))
+	}
+	return Rat{result}, nil
+}
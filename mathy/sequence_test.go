@@ -0,0 +1,95 @@
+package mathy
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestDifferences(t *testing.T) {
+	seq := []int{1, 4, 9, 16, 25}
+	if got := Differences(seq); !reflect.DeepEqual(got, []int{3, 5, 7, 9}) {
+		t.Errorf("Differences() = %v", got)
+	}
+	want := [][]int{seq, {3, 5, 7, 9}, {2, 2, 2}, {0, 0}}
+	if got := DifferenceTable(seq); !reflect.DeepEqual(got, want) {
+		t.Errorf("DifferenceTable() = %v, want %v", got, want)
+	}
+}
+
+func TestPolynomialDegree(t *testing.T) {
+	tests := []struct {
+		name       string
+		seq        []int
+		wantDegree int
+		wantOk     bool
+	}{
+		{"constant", []int{7, 7}, 0, true},
+		{"arithmetic", []int{3, 5, 7, 9}, 1, true},
+		{"cubic", []int{0, 1, 8, 27, 64, 125}, 3, true},
+		{"too short", []int{1, 2}, 0, false},
+		{"exponential", []int{1, 2, 4, 8, 16}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			degree, ok := PolynomialDegree(tt.seq)
+			if degree != tt.wantDegree || ok != tt.wantOk {
+				t.Errorf("PolynomialDegree() = %d, %t, want %d, %t", degree, ok, tt.wantDegree, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestExtrapolate(t *testing.T) {
+	// heights after every cycle of a tower simulation: irregular warm-up, then
+	// a constant growth of 53 per cycle (day 17 example)
+	heights := []int{0, 17, 25, 61, 114, 167, 220, 273}
+	start, degree, ok := DetectPolynomial(heights, 2, 2)
+	if !ok || start != 3 || degree != 1 {
+		t.Fatalf("DetectPolynomial() = %d, %d, %t, want 3, 1, true", start, degree, ok)
+	}
+
+	got, err := Extrapolate(heights, 2, 1_000_000_000_000)
+	want := big.NewInt(0).Add(big.NewInt(61), big.NewInt(53*(1_000_000_000_000-3)))
+	if err != nil || got.Cmp(want) != 0 {
+		t.Errorf("Extrapolate() = %v, %v, want %v", got, err, want)
+	}
+	if got, _ := Extrapolate(heights, 2, 1); got.Int64() != 17 {
+		t.Errorf("Extrapolate() during warm-up = %v, want 17", got)
+	}
+
+	// n^2 overflows int64 for n = 10^10 * 10^10, but not the big.Int
+	squares := []int{0, 1, 4, 9}
+	got, err = ExtrapolateDifferences(squares, 1e10)
+	want = new(big.Int).Mul(big.NewInt(1e10), big.NewInt(1e10))
+	if err != nil || got.Cmp(want) != 0 {
+		t.Errorf("ExtrapolateDifferences() = %v, %v, want %v", got, err, want)
+	}
+	if got, _ := ExtrapolateDifferences(squares, -3); got.Int64() != 9 {
+		t.Errorf("ExtrapolateDifferences(-3) = %v, want 9", got)
+	}
+
+	if _, err := Extrapolate([]int{1, 2, 4, 8, 16}, 2, 10); !errors.Is(err, ErrNotPolynomial) {
+		t.Errorf("Extrapolate() error = %v, want ErrNotPolynomial", err)
+	}
+}
+
+func TestLagrange(t *testing.T) {
+	// quadratic growth sampled at irregular points: f(x) = 2x^2 - x + 5
+	f := func(x int64) int64 { return 2*x*x - x + 5 }
+	xs := []int64{65, 196, 327}
+	ys := []int64{f(65), f(196), f(327)}
+	got, err := Lagrange(xs, ys, 26501365)
+	if err != nil || !got.Equal(NewRat(f(26501365), 1)) {
+		t.Errorf("Lagrange() = %v, %v, want %d", got, err, f(26501365))
+	}
+
+	got, _ = Lagrange([]int64{0, 2}, []int64{0, 1}, 1)
+	if got.String() != "1/2" {
+		t.Errorf("Lagrange() = %v, want 1/2", got)
+	}
+	if _, err := Lagrange([]int64{1, 1}, []int64{0, 1}, 1); err == nil {
+		t.Errorf("Lagrange() with duplicate x should fail")
+	}
+}
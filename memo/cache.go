@@ -0,0 +1,103 @@
+// Package memo caches the results of (recursive) functions, e.g. for the
+// search and dynamic programming puzzles that would otherwise hand-roll a map
+// with formatted string keys
+package memo
+
+import (
+	"container/list"
+	"fmt"
+)
+
+// Stats counts the cache accesses to tune key design and size bounds
+type Stats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+}
+
+// HitRate returns the share of accesses that were answered by the cache
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+func (s Stats) String() string {
+	return fmt.Sprintf("%d hits, %d misses (%.1f%%), %d evictions", s.Hits, s.Misses, s.HitRate()*100, s.Evictions)
+}
+
+// Cache maps keys to values, optionally bounded to a maximum size by evicting
+// the least recently used entry
+type Cache[K comparable, V any] struct {
+	maxSize int
+	values  map[K]V
+	// lru holds the keys from most to least recently used, only if bounded
+	lru      *list.List
+	elements map[K]*list.Element
+	stats    Stats
+}
+
+// NewCache returns an empty cache, maxSize <= 0 means unbounded
+func NewCache[K comparable, V any](maxSize int) *Cache[K, V] {
+	c := &Cache[K, V]{
+		maxSize: maxSize,
+		values:  map[K]V{},
+	}
+	if maxSize > 0 {
+		c.lru = list.New()
+		c.elements = map[K]*list.Element{}
+	}
+	return c
+}
+
+// Get returns the cached value for key and counts a hit or miss
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	val, ok := c.values[key]
+	if !ok {
+		c.stats.Misses++
+		return val, false
+	}
+	c.stats.Hits++
+	if c.lru != nil {
+		c.lru.MoveToFront(c.elements[key])
+	}
+	return val, true
+}
+
+// Put stores the value for key, evicting the least recently used entry if the
+// cache is full
+func (c *Cache[K, V]) Put(key K, val V) {
+	if _, ok := c.values[key]; !ok && c.lru != nil {
+		if c.lru.Len() >= c.maxSize {
+			oldest := c.lru.Remove(c.lru.Back()).(K)
+			delete(c.values, oldest)
+			delete(c.elements, oldest)
+			c.stats.Evictions++
+		}
+		c.elements[key] = c.lru.PushFront(key)
+	} else if c.lru != nil {
+		c.lru.MoveToFront(c.elements[key])
+	}
+	c.values[key] = val
+}
+
+// Len returns the number of cached entries
+func (c *Cache[K, V]) Len() int {
+	return len(c.values)
+}
+
+// Stats returns the hit, miss and eviction counts since creation or Reset
+func (c *Cache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// Reset removes all entries and clears the stats
+func (c *Cache[K, V]) Reset() {
+	c.values = map[K]V{}
+	if c.lru != nil {
+		c.lru.Init()
+		c.elements = map[K]*list.Element{}
+	}
+	c.stats = Stats{}
+}
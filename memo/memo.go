@@ -0,0 +1,73 @@
+package memo
+
+// Option configures a memoized function
+type Option func(*options)
+
+type options struct {
+	maxSize int
+}
+
+// WithMaxSize bounds the cache to n entries, evicting the least recently used
+func WithMaxSize(n int) Option {
+	return func(o *options) {
+		o.maxSize = n
+	}
+}
+
+// Func is a memoized function from A to V, caching results by the key K of
+// its argument
+type Func[A any, K comparable, V any] struct {
+	fn    func(recurse func(A) V, arg A) V
+	key   func(A) K
+	cache *Cache[K, V]
+}
+
+// Memoize wraps fn with a cache keyed by its comparable argument
+// fn receives recurse to call itself through the cache, e.g.
+//
+//	fib := memo.Memoize(func(fib func(int) int, n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return fib(n-1) + fib(n-2)
+//	})
+//	fib.Call(90)
+func Memoize[K comparable, V any](fn func(recurse func(K) V, arg K) V, opts ...Option) *Func[K, K, V] {
+	return MemoizeKey(func(arg K) K { return arg }, fn, opts...)
+}
+
+// MemoizeKey wraps fn with a cache keyed by key(arg), for arguments that are
+// not comparable (e.g. contain maps or slices) or contain fields that do not
+// influence the result
+func MemoizeKey[A any, K comparable, V any](key func(A) K, fn func(recurse func(A) V, arg A) V, opts ...Option) *Func[A, K, V] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Func[A, K, V]{
+		fn:    fn,
+		key:   key,
+		cache: NewCache[K, V](o.maxSize),
+	}
+}
+
+// Call returns the cached result for arg or calculates and caches it
+func (f *Func[A, K, V]) Call(arg A) V {
+	k := f.key(arg)
+	if val, ok := f.cache.Get(k); ok {
+		return val
+	}
+	val := f.fn(f.Call, arg)
+	f.cache.Put(k, val)
+	return val
+}
+
+// Stats returns the cache statistics
+func (f *Func[A, K, V]) Stats() Stats {
+	return f.cache.Stats()
+}
+
+// Reset clears the cache, e.g. before solving the next independent input
+func (f *Func[A, K, V]) Reset() {
+	f.cache.Reset()
+}
@@ -0,0 +1,96 @@
+package memo_test
+
+import (
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/memo"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	fib := memo.Memoize(func(fib func(int) int, n int) int {
+		calls++
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+
+	if got := fib.Call(90); got != 2880067194370816120 {
+		t.Errorf("fib(90) = %d, want 2880067194370816120", got)
+	}
+	if calls != 91 {
+		t.Errorf("fib was evaluated %d times, want 91", calls)
+	}
+	stats := fib.Stats()
+	if stats.Misses != 91 || stats.Hits != 88 {
+		t.Errorf("Stats() = %v, want 91 misses and 88 hits", stats)
+	}
+
+	fib.Call(90)
+	if fib.Stats().Hits != 89 || calls != 91 {
+		t.Errorf("second call should be a single cache hit, got %v", fib.Stats())
+	}
+
+	fib.Reset()
+	if fib.Stats() != (memo.Stats{}) {
+		t.Errorf("Reset() did not clear the stats: %v", fib.Stats())
+	}
+}
+
+func TestMemoizeKey(t *testing.T) {
+	type arg struct {
+		path []string
+		n    int
+	}
+	calls := 0
+	count := memo.MemoizeKey(func(a arg) int { return a.n }, func(count func(arg) int, a arg) int {
+		calls++
+		if a.n == 0 {
+			return 1
+		}
+		// the path does not influence the result, so it is not part of the key
+		return count(arg{append(a.path, "l"), a.n - 1}) + count(arg{append(a.path, "r"), a.n - 1})
+	})
+	if got := count.Call(arg{nil, 30}); got != 1<<30 {
+		t.Errorf("count(30) = %d, want %d", got, 1<<30)
+	}
+	if calls != 31 {
+		t.Errorf("count was evaluated %d times, want 31", calls)
+	}
+}
+
+func TestCacheLRU(t *testing.T) {
+	c := memo.NewCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // b is now the least recently used
+	c.Put("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("b should have been evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Get(a) = %d, %t, want 1, true", v, ok)
+	}
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Errorf("Get(c) = %d, %t, want 3, true", v, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", c.Len())
+	}
+	want := memo.Stats{Hits: 3, Misses: 1, Evictions: 1}
+	if c.Stats() != want {
+		t.Errorf("Stats() = %v, want %v", c.Stats(), want)
+	}
+
+	bounded := memo.Memoize(func(fib func(int) int, n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	}, memo.WithMaxSize(3))
+	if got := bounded.Call(50); got != 12586269025 {
+		t.Errorf("bounded fib(50) = %d, want 12586269025", got)
+	}
+}
@@ -0,0 +1,145 @@
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var intPattern = regexp.MustCompile(`-?\d+`)
+
+// Ints extracts all signed integers from s, ignoring everything around them,
+// e.g. "Sensor at x=-2, y=15" => [-2 15]
+func Ints(s string) []int {
+	matches := intPattern.FindAllString(s, -1)
+	ints := make([]int, 0, len(matches))
+	for _, match := range matches {
+		n, err := strconv.Atoi(match)
+		if err != nil {
+			// only possible for numbers that overflow an int
+			panic(fmt.Sprintf("parsing %q: %v", match, err))
+		}
+		ints = append(ints, n)
+	}
+	return ints
+}
+
+// Blocks splits input at blank lines (e.g. one block per elf or monkey),
+// surrounding whitespace and repeated blank lines are ignored
+func Blocks(input string) []string {
+	var blocks []string
+	for _, block := range BlockLines(input) {
+		blocks = append(blocks, strings.Join(block, "\n"))
+	}
+	return blocks
+}
+
+// BlockLines is Blocks with every block split into its lines
+func BlockLines(input string) [][]string {
+	blocks, _ := BlockLinesAt(input)
+	return blocks
+}
+
+// BlockLinesAt is BlockLines, but also returns the index of the first line of
+// every block in input, e.g. to report errors with the line number of the input
+func BlockLinesAt(input string) (blocks [][]string, starts []int) {
+	var current []string
+	for i, line := range strings.Split(strings.ReplaceAll(input, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				blocks = append(blocks, current)
+				current = nil
+			}
+			continue
+		}
+		if len(current) == 0 {
+			starts = append(starts, i)
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		blocks = append(blocks, current)
+	}
+	return blocks, starts
+}
+
+// Split is like strings.Split, but splits at every occurrence of any of the
+// separators. At a position where multiple separators match the longest wins
+func Split(s string, seps ...string) []string {
+	// try longer separators first, so "->" wins over "-"
+	sorted := append([]string{}, seps...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); {
+		matched := ""
+		for _, sep := range sorted {
+			if sep != "" && strings.HasPrefix(s[i:], sep) {
+				matched = sep
+				break
+			}
+		}
+		if matched == "" {
+			i++
+			continue
+		}
+		parts = append(parts, s[start:i])
+		i += len(matched)
+		start = i
+	}
+	return append(parts, s[start:])
+}
+
+// Fields splits s at any of the separators like Split, but trims the parts
+// and drops empty ones. Without separators it splits at whitespace
+func Fields(s string, seps ...string) []string {
+	if len(seps) == 0 {
+		return strings.Fields(s)
+	}
+	var fields []string
+	for _, part := range Split(s, seps...) {
+		if part = strings.TrimSpace(part); part != "" {
+			fields = append(fields, part)
+		}
+	}
+	return fields
+}
+
+// IntFields is Fields with every field converted to an int
+func IntFields(s string, seps ...string) ([]int, error) {
+	fields := Fields(s, seps...)
+	ints := make([]int, 0, len(fields))
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("field %d: %w", i+1, err)
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
+
+// RuneGrid parses input into rows of runes and locates the first occurrence
+// of every marker as [row, col], e.g. the start S and end E of a height map
+// Markers that do not occur are missing from the map
+func RuneGrid(input string, markers ...rune) ([][]rune, map[rune][2]int) {
+	lines := strings.Split(strings.TrimRight(input, "\n"), "\n")
+	grid := make([][]rune, len(lines))
+	found := map[rune][2]int{}
+	for row, line := range lines {
+		grid[row] = []rune(line)
+		for col, r := range grid[row] {
+			for _, marker := range markers {
+				if _, ok := found[marker]; r == marker && !ok {
+					found[marker] = [2]int{row, col}
+				}
+			}
+		}
+	}
+	return grid, found
+}
@@ -0,0 +1,75 @@
+package parse_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/parse"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []int
+	}{
+		{"Sensor at x=-2, y=15: closest beacon is at x=10, y=-16", []int{-2, 15, 10, -16}},
+		{"move 13 from 2 to 8", []int{13, 2, 8}},
+		{"  Starting items: 79, 98", []int{79, 98}},
+		{"no numbers", []int{}},
+	}
+	for _, tt := range tests {
+		if got := parse.Ints(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Ints(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	input := "\n1000\n2000\n\n4000\n\n\n5000\r\n6000\r\n"
+	want := []string{"1000\n2000", "4000", "5000\n6000"}
+	if got := parse.Blocks(input); !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocks() = %q, want %q", got, want)
+	}
+	wantLines := [][]string{{"1000", "2000"}, {"4000"}, {"5000", "6000"}}
+	if got := parse.BlockLines(input); !reflect.DeepEqual(got, wantLines) {
+		t.Errorf("BlockLines() = %q, want %q", got, wantLines)
+	}
+	if got, starts := parse.BlockLinesAt(input); !reflect.DeepEqual(got, wantLines) || !reflect.DeepEqual(starts, []int{1, 4, 7}) {
+		t.Errorf("BlockLinesAt() = %q, %v, want %q, [1 4 7]", got, starts, wantLines)
+	}
+}
+
+func TestSplitAndFields(t *testing.T) {
+	if got, want := parse.Split("498,4 -> 498,6", " -> ", ","), []string{"498", "4", "498", "6"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %q, want %q", got, want)
+	}
+	// the longer separator wins, empty parts are kept
+	if got, want := parse.Split("a->b--c", "-", "->"), []string{"a", "b", "", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %q, want %q", got, want)
+	}
+	if got, want := parse.Fields("2-4, 6-8 ,", ",", "-"), []string{"2", "4", "6", "8"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %q, want %q", got, want)
+	}
+	if got, want := parse.Fields("  a  b "), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %q, want %q", got, want)
+	}
+
+	got, err := parse.IntFields("2-4,6-8", ",", "-")
+	if err != nil || !reflect.DeepEqual(got, []int{2, 4, 6, 8}) {
+		t.Errorf("IntFields() = %v, %v, want [2 4 6 8]", got, err)
+	}
+	if _, err := parse.IntFields("2-x", "-"); err == nil {
+		t.Errorf("IntFields() should fail for non numeric fields")
+	}
+}
+
+func TestRuneGrid(t *testing.T) {
+	grid, markers := parse.RuneGrid("Sabqponm\nabcryxxl\naccszExk\n", 'S', 'E', 'Z')
+	if len(grid) != 3 || string(grid[2]) != "accszExk" {
+		t.Errorf("RuneGrid() = %q", grid)
+	}
+	want := map[rune][2]int{'S': {0, 0}, 'E': {2, 5}}
+	if !reflect.DeepEqual(markers, want) {
+		t.Errorf("RuneGrid() markers = %v, want %v", markers, want)
+	}
+}
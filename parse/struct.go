@@ -0,0 +1,326 @@
+// Package parse turns puzzle inputs into typed values
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrNoMatch is returned if a line does not match a required pattern
+var ErrNoMatch = errors.New("no match")
+
+// ErrRecursive is returned for structs that contain a nested struct of their
+// own type, as nested structs are parsed from the same line
+var ErrRecursive = errors.New("recursive struct")
+
+// Lines parses every non empty line of input into a T, which has to be a
+// struct whose fields are tagged with a regular expression, e.g.
+//
+//	type Valve struct {
+//		ID      string   `re:"Valve (\\w+)"`
+//		Rate    int      `re:"flow rate=(?P<Rate>\\d+)"`
+//		Tunnels []string `re:"valves? (.*)" sep:", "`
+//		Target  *int     `re:"target=(\\d+)" parse:"optional"`
+//	}
+//
+// Tags are Go strings, so backslashes have to be doubled
+// Every pattern is searched in the line on its own. The field gets the
+// capture group named like the field, else the first group, else the whole
+// match. Supported field types are strings, bools, ints, uints and floats,
+// slices of them (split by the sep tag, or at whitespace without one),
+// pointers to them and nested structs whose fields are tagged the same way,
+// as long as they do not contain themselves (ErrRecursive)
+// Fields tagged `parse:"optional"` stay empty if they do not match
+// Errors contain the line number and field that failed
+func Lines[T any](input string) ([]T, error) {
+	var ans []T
+	for i, line := range strings.Split(input, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		val, err := Line[T](line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		ans = append(ans, val)
+	}
+	return ans, nil
+}
+
+// MustLines is Lines, but panics on errors
+func MustLines[T any](input string) []T {
+	ans, err := Lines[T](input)
+	if err != nil {
+		panic(err)
+	}
+	return ans
+}
+
+// Line parses a single line into a T, see Lines
+func Line[T any](line string) (T, error) {
+	var val T
+	spec, err := specFor(reflect.TypeOf(val))
+	if err != nil {
+		return val, err
+	}
+	err = spec.parse(reflect.ValueOf(&val).Elem(), line)
+	return val, err
+}
+
+// Regexp parses every non empty line of input into a T by matching it with
+// re as a whole. Every named capture group is stored in the exported field of
+// the same name, with the same conversions and sep tag as Lines
+func Regexp[T any](re *regexp.Regexp, input string) ([]T, error) {
+	var zero T
+	typ := reflect.TypeOf(zero)
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can only parse into structs, got %v", typ)
+	}
+	groups := map[int]regexpField{}
+	for group, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		field, ok := typ.FieldByName(name)
+		if !ok || field.PkgPath != "" {
+			return nil, fmt.Errorf("no exported field %s in %v for group %q", name, typ, name)
+		}
+		sep, hasSep := field.Tag.Lookup("sep")
+		groups[group] = regexpField{
+			path:      field.Index,
+			fieldSpec: fieldSpec{name: name, sep: sep, hasSep: hasSep},
+		}
+	}
+
+	var ans []T
+	for i, line := range strings.Split(input, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		matches := re.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, fmt.Errorf("line %d: %q does not match %q: %w", i+1, line, re, ErrNoMatch)
+		}
+		var val T
+		v := reflect.ValueOf(&val).Elem()
+		for group, field := range groups {
+			if err := field.set(fieldByPath(v, field.path), matches[group]); err != nil {
+				return nil, fmt.Errorf("line %d: field %s: %w", i+1, field.name, err)
+			}
+		}
+		ans = append(ans, val)
+	}
+	return ans, nil
+}
+
+// regexpField is a field set by Regexp, path is the index sequence to reach
+// it, which is longer than one for fields promoted from embedded structs
+type regexpField struct {
+	fieldSpec
+	path []int
+}
+
+// fieldByPath is reflect.Value.FieldByIndex, but allocates nil embedded
+// struct pointers on the way instead of panicking
+func fieldByPath(v reflect.Value, path []int) reflect.Value {
+	for i, index := range path {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(index)
+	}
+	return v
+}
+
+// structSpec holds the compiled tags of a struct type
+type structSpec struct {
+	fields []fieldSpec
+}
+
+type fieldSpec struct {
+	index    int
+	name     string
+	re       *regexp.Regexp
+	group    int
+	sep      string
+	hasSep   bool
+	optional bool
+	// nested is set for struct and *struct fields without a pattern
+	nested *structSpec
+}
+
+var specs sync.Map // reflect.Type => *structSpec
+
+func specFor(typ reflect.Type) (*structSpec, error) {
+	return buildSpec(typ, map[reflect.Type]bool{})
+}
+
+// buildSpec compiles the tags of typ, parents holds the struct types typ is
+// nested in to reject recursive types
+func buildSpec(typ reflect.Type, parents map[reflect.Type]bool) (*structSpec, error) {
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can only parse into structs, got %v", typ)
+	}
+	if spec, ok := specs.Load(typ); ok {
+		return spec.(*structSpec), nil
+	}
+	if parents[typ] {
+		return nil, fmt.Errorf("%v contains itself: %w", typ, ErrRecursive)
+	}
+	parents[typ] = true
+	defer delete(parents, typ)
+
+	spec := &structSpec{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		pattern, hasPattern := field.Tag.Lookup("re")
+		if field.PkgPath != "" {
+			if hasPattern {
+				return nil, fmt.Errorf("field %s of %v is tagged but not exported", field.Name, typ)
+			}
+			continue
+		}
+
+		fs := fieldSpec{
+			index:    i,
+			name:     field.Name,
+			optional: field.Tag.Get("parse") == "optional",
+		}
+		fs.sep, fs.hasSep = field.Tag.Lookup("sep")
+
+		if !hasPattern {
+			nestedType := field.Type
+			if nestedType.Kind() == reflect.Ptr {
+				nestedType = nestedType.Elem()
+			}
+			if nestedType.Kind() != reflect.Struct {
+				continue
+			}
+			nested, err := buildSpec(nestedType, parents)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			fs.nested = nested
+			spec.fields = append(spec.fields, fs)
+			continue
+		}
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		fs.re = re
+		if named := re.SubexpIndex(field.Name); named > 0 {
+			fs.group = named
+		} else if re.NumSubexp() > 0 {
+			fs.group = 1
+		}
+		spec.fields = append(spec.fields, fs)
+	}
+
+	actual, _ := specs.LoadOrStore(typ, spec)
+	return actual.(*structSpec), nil
+}
+
+// parse fills the struct v from line
+func (s *structSpec) parse(v reflect.Value, line string) error {
+	for _, field := range s.fields {
+		fv := v.Field(field.index)
+		if field.nested != nil {
+			if err := field.parseNested(fv, line); err != nil && !field.optional {
+				return fmt.Errorf("field %s: %w", field.name, err)
+			}
+			continue
+		}
+
+		loc := field.re.FindStringSubmatchIndex(line)
+		if loc == nil || loc[2*field.group] < 0 {
+			if field.optional {
+				continue
+			}
+			return fmt.Errorf("field %s: %q does not match %q: %w", field.name, line, field.re, ErrNoMatch)
+		}
+		if err := field.set(fv, line[loc[2*field.group]:loc[2*field.group+1]]); err != nil {
+			return fmt.Errorf("field %s: %w", field.name, err)
+		}
+	}
+	return nil
+}
+
+// parseNested only sets fv if the whole nested struct could be parsed
+func (f fieldSpec) parseNested(fv reflect.Value, line string) error {
+	target := reflect.New(fv.Type())
+	elem := target.Elem()
+	if fv.Kind() == reflect.Ptr {
+		elem.Set(reflect.New(fv.Type().Elem()))
+		elem = elem.Elem()
+	}
+	if err := f.nested.parse(elem, line); err != nil {
+		return err
+	}
+	fv.Set(target.Elem())
+	return nil
+}
+
+// set converts str into the type of fv
+func (f fieldSpec) set(fv reflect.Value, str string) error {
+	switch fv.Kind() {
+	case reflect.Ptr:
+		ptr := reflect.New(fv.Type().Elem())
+		if err := f.set(ptr.Elem(), str); err != nil {
+			return err
+		}
+		fv.Set(ptr)
+	case reflect.Slice:
+		var parts []string
+		if f.hasSep {
+			parts = strings.Split(str, f.sep)
+		} else {
+			parts = strings.Fields(str)
+		}
+		slice := reflect.MakeSlice(fv.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := f.set(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+	case reflect.String:
+		fv.SetString(str)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(str))
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(str), 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(str), 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(strings.TrimSpace(str), fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported type %v", fv.Type())
+	}
+	return nil
+}
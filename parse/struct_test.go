@@ -0,0 +1,160 @@
+package parse_test
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/parse"
+)
+
+type valve struct {
+	ID      string   `re:"Valve (\\w+)"`
+	Rate    int      `re:"flow rate=(?P<Rate>\\d+)"`
+	Tunnels []string `re:"valves? (.*)" sep:", "`
+}
+
+var valves = `Valve AA has flow rate=0; tunnels lead to valves DD, II, BB
+Valve BB has flow rate=13; tunnels lead to valves CC, AA
+Valve HH has flow rate=22; tunnel leads to valve GG
+`
+
+func TestLines(t *testing.T) {
+	got, err := parse.Lines[valve](valves)
+	if err != nil {
+		t.Fatalf("Lines() error = %v", err)
+	}
+	want := []valve{
+		{"AA", 0, []string{"DD", "II", "BB"}},
+		{"BB", 13, []string{"CC", "AA"}},
+		{"HH", 22, []string{"GG"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %+v, want %+v", got, want)
+	}
+
+	_, err = parse.Lines[valve](valves + "Valve CC has no flow rate")
+	if !errors.Is(err, parse.ErrNoMatch) || !strings.Contains(err.Error(), "line 4") || !strings.Contains(err.Error(), "Rate") {
+		t.Errorf("Lines() error = %v, want ErrNoMatch for Rate in line 4", err)
+	}
+}
+
+type point struct {
+	X int `re:"x=(-?\\d+)"`
+	Y int `re:"y=(-?\\d+)"`
+}
+
+type sensor struct {
+	Pos    point
+	Nums   []int64 `re:"nums: ([\\d ]+)"`
+	Target *point  `parse:"optional"`
+	Label  *string `re:"label=(\\w+)" parse:"optional"`
+	Ratio  float64 `re:"ratio=([\\d.]+)" parse:"optional"`
+	Active bool    `re:"active=(\\w+)" parse:"optional"`
+	hidden int
+}
+
+func TestLineNestedAndOptional(t *testing.T) {
+	got, err := parse.Line[sensor]("x=-2, y=15 nums: 1 2 3 label=abc ratio=0.5 active=true")
+	if err != nil {
+		t.Fatalf("Line() error = %v", err)
+	}
+	label := "abc"
+	// Target is parsed from the same line as Pos, as both use the same tags
+	want := sensor{
+		Pos:    point{-2, 15},
+		Nums:   []int64{1, 2, 3},
+		Target: &point{-2, 15},
+		Label:  &label,
+		Ratio:  0.5,
+		Active: true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Line() = %+v, want %+v", got, want)
+	}
+
+	_, err = parse.Line[sensor]("x=1 nums: 4")
+	if !errors.Is(err, parse.ErrNoMatch) || !strings.Contains(err.Error(), "Pos") {
+		t.Errorf("Line() error = %v, want ErrNoMatch for the nested Pos", err)
+	}
+
+	type optionalTarget struct {
+		Nums   []int  `re:"nums: ([\\d ]+)"`
+		Target *point `parse:"optional"`
+	}
+	opt, err := parse.Line[optionalTarget]("nums: 4 5")
+	if err != nil || opt.Target != nil || !reflect.DeepEqual(opt.Nums, []int{4, 5}) {
+		t.Errorf("Line() = %+v, %v, want nil Target", opt, err)
+	}
+
+	type overflow struct {
+		N int8 `re:"(\\d+)"`
+	}
+	if _, err := parse.Line[overflow]("300"); err == nil {
+		t.Errorf("Line() should fail for values that overflow the field")
+	}
+}
+
+func TestRegexp(t *testing.T) {
+	type blueprint struct {
+		ID    int
+		Ore   int
+		Costs []int `sep:","`
+	}
+	re := regexp.MustCompile(`Blueprint (?P<ID>\d+): (?P<Ore>\d+) ore, costs (?P<Costs>[\d,]+)`)
+	got, err := parse.Regexp[blueprint](re, "Blueprint 1: 4 ore, costs 2,3\nBlueprint 2: 2 ore, costs 3")
+	want := []blueprint{{1, 4, []int{2, 3}}, {2, 2, []int{3}}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Regexp() = %+v, %v, want %+v", got, err, want)
+	}
+
+	if _, err := parse.Regexp[blueprint](re, "Blueprint 1: 4 ore, costs 2\nnope"); !errors.Is(err, parse.ErrNoMatch) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Regexp() error = %v, want ErrNoMatch in line 2", err)
+	}
+	if _, err := parse.Regexp[blueprint](regexp.MustCompile(`(?P<Unknown>\d+)`), "1"); err == nil {
+		t.Errorf("Regexp() should fail for groups without a field")
+	}
+}
+
+type Cost struct {
+	Ore  int
+	Clay int
+}
+
+type Robot struct {
+	Name string
+	*Cost
+}
+
+func TestRegexpEmbedded(t *testing.T) {
+	re := regexp.MustCompile(`(?P<Name>\w+) robot costs (?P<Ore>\d+) ore and (?P<Clay>\d+) clay`)
+	got, err := parse.Regexp[Robot](re, "obsidian robot costs 3 ore and 14 clay")
+	want := []Robot{{Name: "obsidian", Cost: &Cost{Ore: 3, Clay: 14}}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Regexp() = %+v, %v, want %+v", got, err, want)
+	}
+}
+
+type node struct {
+	V    int   `re:"(\\d+)"`
+	Next *node `parse:"optional"`
+}
+
+type outer struct {
+	Inner inner
+}
+
+type inner struct {
+	Back *outer
+}
+
+func TestLineRecursive(t *testing.T) {
+	if _, err := parse.Line[node]("5"); !errors.Is(err, parse.ErrRecursive) {
+		t.Errorf("Line[node]() error = %v, want ErrRecursive", err)
+	}
+	if _, err := parse.Line[outer]("5"); !errors.Is(err, parse.ErrRecursive) {
+		t.Errorf("Line[outer]() error = %v, want ErrRecursive", err)
+	}
+}
@@ -42,6 +42,11 @@ func part1(input string) int {
 	parsed := parseInput(input)
 	_ = parsed
 
+	// independent sub-problems can be solved concurrently, the results keep
+	// the input order (see also ParallelReduce and First for early exits):
+	// results, err := concurrency.ParallelMap(context.Background(), parsed, 0,
+	// 	func(ctx context.Context, item int) (int, error) { ... })
+
 	return 0
 }
 
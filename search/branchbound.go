@@ -0,0 +1,249 @@
+// Package search contains generic engines for the "what to do next" puzzles
+// that explore a tree of decisions for the best reachable score
+package search
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/mheidinger/advent-of-code-go/data-structures/heap"
+)
+
+// Problem describes a maximization problem over states of type S
+// Every state is a candidate solution with its own score, the search looks
+// for the state with the highest score reachable from the initial one
+type Problem[S any, K comparable] struct {
+	// Successors returns the states reachable with one more decision
+	Successors func(s S) []S
+	// Score returns the score if the search stops in s
+	Score func(s S) int
+	// UpperBound optimistically estimates the best score reachable from s,
+	// subtrees with a bound not above the best known score are pruned
+	// Optional, but without it the search is exhaustive
+	UpperBound func(s S) int
+	// Key and Dominates are optional: of two states with the same key, b is
+	// pruned if Dominates(a, b), i.e. a reaches at least as good scores as b
+	Key       func(s S) K
+	Dominates func(a, b S) bool
+}
+
+// Stats counts the work done by a search
+type Stats struct {
+	Expanded  int
+	Pruned    int
+	Dominated int
+}
+
+func (s *Stats) add(o Stats) {
+	s.Expanded += o.Expanded
+	s.Pruned += o.Pruned
+	s.Dominated += o.Dominated
+}
+
+// Result is the best state found and how it was reached
+type Result[S any] struct {
+	Score int
+	// Path holds all states from the initial to the best state
+	Path  []S
+	Stats Stats
+}
+
+// Best returns the best state found
+func (r Result[S]) Best() S {
+	return r.Path[len(r.Path)-1]
+}
+
+// DFS searches depth first, exploring successors in the order returned by
+// Successors. Returning the most promising successors first prunes more
+func DFS[S any, K comparable](p Problem[S, K], initial S) Result[S] {
+	inc := newIncumbent(p.Score(initial), []S{initial})
+	e := newEngine(p, inc)
+	e.dfs(initial, nil)
+	return Result[S]{Score: inc.load(), Path: inc.path, Stats: e.stats}
+}
+
+// ParallelDFS runs DFS on the subtrees of the initial successors with up to
+// workers goroutines. The best score is shared for pruning, but each subtree
+// checks dominance only against its own states
+func ParallelDFS[S any, K comparable](p Problem[S, K], initial S, workers int) Result[S] {
+	if workers < 1 {
+		workers = 1
+	}
+	inc := newIncumbent(p.Score(initial), []S{initial})
+	root := newEngine(p, inc)
+	root.stats.Expanded++
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		stats = root.stats
+		sem   = make(chan struct{}, workers)
+	)
+	for _, next := range p.Successors(initial) {
+		if root.prune(next) {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(next S) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			e := newEngine(p, inc)
+			e.dfs(next, []S{initial})
+			mu.Lock()
+			stats.add(e.stats)
+			mu.Unlock()
+		}(next)
+	}
+	wg.Wait()
+	stats.add(Stats{Pruned: root.stats.Pruned, Dominated: root.stats.Dominated})
+	return Result[S]{Score: inc.load(), Path: inc.path, Stats: stats}
+}
+
+// BestFirst always expands the state with the highest upper bound (or score
+// if there is no UpperBound) next and stops once no state can improve on the
+// best score anymore
+func BestFirst[S any, K comparable](p Problem[S, K], initial S) Result[S] {
+	inc := newIncumbent(p.Score(initial), []S{initial})
+	e := newEngine(p, inc)
+
+	queue := heap.NewMaxHeap()
+	queue.Add(e.newNode(initial, nil))
+	for queue.Length() > 0 {
+		current := queue.Remove().(*node[S])
+		if p.UpperBound != nil && current.priority <= inc.load() {
+			// all remaining nodes have an even lower bound
+			e.stats.Pruned += queue.Length() + 1
+			break
+		}
+
+		e.stats.Expanded++
+		if score := p.Score(current.state); score > inc.load() {
+			inc.offer(score, current.path())
+		}
+		for _, next := range p.Successors(current.state) {
+			if !e.prune(next) {
+				queue.Add(e.newNode(next, current))
+			}
+		}
+	}
+	return Result[S]{Score: inc.load(), Path: inc.path, Stats: e.stats}
+}
+
+// incumbent is the best solution found so far, shared by parallel searches
+type incumbent[S any] struct {
+	score int64
+	mu    sync.Mutex
+	path  []S
+}
+
+func newIncumbent[S any](score int, path []S) *incumbent[S] {
+	return &incumbent[S]{score: int64(score), path: path}
+}
+
+func (inc *incumbent[S]) load() int {
+	return int(atomic.LoadInt64(&inc.score))
+}
+
+// offer replaces the best solution if score is better, path is copied
+func (inc *incumbent[S]) offer(score int, path []S) {
+	inc.mu.Lock()
+	defer inc.mu.Unlock()
+	if int64(score) <= inc.score {
+		return
+	}
+	inc.path = append([]S{}, path...)
+	atomic.StoreInt64(&inc.score, int64(score))
+}
+
+type engine[S any, K comparable] struct {
+	p     Problem[S, K]
+	inc   *incumbent[S]
+	seen  map[K][]S
+	stats Stats
+}
+
+func newEngine[S any, K comparable](p Problem[S, K], inc *incumbent[S]) *engine[S, K] {
+	return &engine[S, K]{p: p, inc: inc, seen: map[K][]S{}}
+}
+
+func (e *engine[S, K]) dfs(s S, path []S) {
+	path = append(path, s)
+	e.stats.Expanded++
+	if score := e.p.Score(s); score > e.inc.load() {
+		e.inc.offer(score, path)
+	}
+	for _, next := range e.p.Successors(s) {
+		if !e.prune(next) {
+			e.dfs(next, path)
+		}
+	}
+}
+
+// prune returns true if s can not lead to a better solution
+func (e *engine[S, K]) prune(s S) bool {
+	if e.p.UpperBound != nil && e.p.UpperBound(s) <= e.inc.load() {
+		e.stats.Pruned++
+		return true
+	}
+	if e.dominated(s) {
+		e.stats.Dominated++
+		return true
+	}
+	return false
+}
+
+// dominated checks s against all previous states with the same key and
+// remembers s otherwise, dropping the states it dominates itself
+func (e *engine[S, K]) dominated(s S) bool {
+	if e.p.Key == nil || e.p.Dominates == nil {
+		return false
+	}
+	key := e.p.Key(s)
+	for _, other := range e.seen[key] {
+		if e.p.Dominates(other, s) {
+			return true
+		}
+	}
+	kept := e.seen[key][:0]
+	for _, other := range e.seen[key] {
+		if !e.p.Dominates(s, other) {
+			kept = append(kept, other)
+		}
+	}
+	e.seen[key] = append(kept, s)
+	return false
+}
+
+// node is a state in the BestFirst queue, linked to its parent for the path
+type node[S any] struct {
+	state    S
+	parent   *node[S]
+	priority int
+}
+
+func (e *engine[S, K]) newNode(s S, parent *node[S]) *node[S] {
+	priority := e.p.Score(s)
+	if e.p.UpperBound != nil {
+		priority = e.p.UpperBound(s)
+	}
+	return &node[S]{state: s, parent: parent, priority: priority}
+}
+
+// Value implements heap.HeapNode
+func (n *node[S]) Value() int {
+	return n.priority
+}
+
+func (n *node[S]) path() []S {
+	var path []S
+	for current := n; current != nil; current = current.parent {
+		path = append(path, current.state)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
@@ -0,0 +1,90 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/mheidinger/advent-of-code-go/search"
+)
+
+var (
+	weights  = []int{12, 7, 11, 8, 9, 6, 14, 5, 10, 3}
+	values   = []int{24, 13, 23, 15, 16, 11, 25, 9, 17, 4}
+	capacity = 40
+)
+
+// knapsack decides for one item after the other whether to take it
+type knapsack struct {
+	next   int
+	weight int
+	value  int
+}
+
+func knapsackProblem(bound, dominance bool) search.Problem[knapsack, int] {
+	p := search.Problem[knapsack, int]{
+		Successors: func(s knapsack) []knapsack {
+			if s.next == len(weights) {
+				return nil
+			}
+			skip := knapsack{s.next + 1, s.weight, s.value}
+			if s.weight+weights[s.next] > capacity {
+				return []knapsack{skip}
+			}
+			take := knapsack{s.next + 1, s.weight + weights[s.next], s.value + values[s.next]}
+			return []knapsack{take, skip}
+		},
+		Score: func(s knapsack) int {
+			return s.value
+		},
+	}
+	if bound {
+		p.UpperBound = func(s knapsack) int {
+			bound := s.value
+			for _, v := range values[s.next:] {
+				bound += v
+			}
+			return bound
+		}
+	}
+	if dominance {
+		p.Key = func(s knapsack) int {
+			return s.next
+		}
+		p.Dominates = func(a, b knapsack) bool {
+			return a.weight <= b.weight && a.value >= b.value
+		}
+	}
+	return p
+}
+
+func TestSearch(t *testing.T) {
+	exhaustive := search.DFS(knapsackProblem(false, false), knapsack{})
+	if exhaustive.Stats.Pruned != 0 || exhaustive.Stats.Dominated != 0 {
+		t.Fatalf("exhaustive search pruned: %+v", exhaustive.Stats)
+	}
+	want := exhaustive.Score
+
+	tests := []struct {
+		name   string
+		search func(p search.Problem[knapsack, int], initial knapsack) search.Result[knapsack]
+	}{
+		{"dfs", search.DFS[knapsack, int]},
+		{"best first", search.BestFirst[knapsack, int]},
+		{"parallel dfs", func(p search.Problem[knapsack, int], initial knapsack) search.Result[knapsack] {
+			return search.ParallelDFS(p, initial, 4)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.search(knapsackProblem(true, true), knapsack{})
+			if got.Score != want {
+				t.Errorf("Score = %d, want %d", got.Score, want)
+			}
+			if got.Best().value != got.Score || got.Path[0] != (knapsack{}) || len(got.Path) != got.Best().next+1 {
+				t.Errorf("Path %v does not lead from the initial to the best state", got.Path)
+			}
+			if got.Stats.Expanded >= exhaustive.Stats.Expanded || got.Stats.Pruned+got.Stats.Dominated == 0 {
+				t.Errorf("Stats = %+v, want less than %d expanded nodes", got.Stats, exhaustive.Stats.Expanded)
+			}
+		})
+	}
+}